@@ -20,13 +20,217 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type Estimator int32
+
+const (
+	Estimator_ESTIMATOR_NAIVE        Estimator = 0 // Independent uniformly random deals
+	Estimator_ESTIMATOR_STRATIFIED   Estimator = 1 // Stratified over the next card to come
+	Estimator_ESTIMATOR_ANTITHETIC   Estimator = 2 // Deals paired with a rank-mirrored, suit-swapped partner
+	Estimator_ESTIMATOR_QUASI_RANDOM Estimator = 3 // Randomly shifted Halton low-discrepancy dealing
+)
+
+// Enum value maps for Estimator.
+var (
+	Estimator_name = map[int32]string{
+		0: "ESTIMATOR_NAIVE",
+		1: "ESTIMATOR_STRATIFIED",
+		2: "ESTIMATOR_ANTITHETIC",
+		3: "ESTIMATOR_QUASI_RANDOM",
+	}
+	Estimator_value = map[string]int32{
+		"ESTIMATOR_NAIVE":        0,
+		"ESTIMATOR_STRATIFIED":   1,
+		"ESTIMATOR_ANTITHETIC":   2,
+		"ESTIMATOR_QUASI_RANDOM": 3,
+	}
+)
+
+func (x Estimator) Enum() *Estimator {
+	p := new(Estimator)
+	*p = x
+	return p
+}
+
+func (x Estimator) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Estimator) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_poker_proto_enumTypes[0].Descriptor()
+}
+
+func (Estimator) Type() protoreflect.EnumType {
+	return &file_proto_poker_proto_enumTypes[0]
+}
+
+func (x Estimator) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Estimator.Descriptor instead.
+func (Estimator) EnumDescriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{0}
+}
+
+type RangeOperation int32
+
+const (
+	RangeOperation_RANGE_UNION     RangeOperation = 0 // Combos in either range, larger weight
+	RangeOperation_RANGE_INTERSECT RangeOperation = 1 // Combos in both ranges, smaller weight
+	RangeOperation_RANGE_SUBTRACT  RangeOperation = 2 // Left range with the right range's weight taken away
+	RangeOperation_RANGE_WEIGHT    RangeOperation = 3 // Left range scaled by weight; right is ignored
+)
+
+// Enum value maps for RangeOperation.
+var (
+	RangeOperation_name = map[int32]string{
+		0: "RANGE_UNION",
+		1: "RANGE_INTERSECT",
+		2: "RANGE_SUBTRACT",
+		3: "RANGE_WEIGHT",
+	}
+	RangeOperation_value = map[string]int32{
+		"RANGE_UNION":     0,
+		"RANGE_INTERSECT": 1,
+		"RANGE_SUBTRACT":  2,
+		"RANGE_WEIGHT":    3,
+	}
+)
+
+func (x RangeOperation) Enum() *RangeOperation {
+	p := new(RangeOperation)
+	*p = x
+	return p
+}
+
+func (x RangeOperation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RangeOperation) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_poker_proto_enumTypes[1].Descriptor()
+}
+
+func (RangeOperation) Type() protoreflect.EnumType {
+	return &file_proto_poker_proto_enumTypes[1]
+}
+
+func (x RangeOperation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RangeOperation.Descriptor instead.
+func (RangeOperation) EnumDescriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{1}
+}
+
+type QueryMethod int32
+
+const (
+	QueryMethod_QUERY_AUTO        QueryMethod = 0 // Exact when the deals are few enough to enumerate
+	QueryMethod_QUERY_EXACT       QueryMethod = 1 // Enumerate every deal
+	QueryMethod_QUERY_MONTE_CARLO QueryMethod = 2 // Sample deals
+)
+
+// Enum value maps for QueryMethod.
+var (
+	QueryMethod_name = map[int32]string{
+		0: "QUERY_AUTO",
+		1: "QUERY_EXACT",
+		2: "QUERY_MONTE_CARLO",
+	}
+	QueryMethod_value = map[string]int32{
+		"QUERY_AUTO":        0,
+		"QUERY_EXACT":       1,
+		"QUERY_MONTE_CARLO": 2,
+	}
+)
+
+func (x QueryMethod) Enum() *QueryMethod {
+	p := new(QueryMethod)
+	*p = x
+	return p
+}
+
+func (x QueryMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QueryMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_poker_proto_enumTypes[2].Descriptor()
+}
+
+func (QueryMethod) Type() protoreflect.EnumType {
+	return &file_proto_poker_proto_enumTypes[2]
+}
+
+func (x QueryMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QueryMethod.Descriptor instead.
+func (QueryMethod) EnumDescriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{2}
+}
+
+type ICMMethod int32
+
+const (
+	ICMMethod_ICM_AUTO        ICMMethod = 0 // Exact for small fields, Monte Carlo for large ones
+	ICMMethod_ICM_EXACT       ICMMethod = 1 // Malmuth-Harville, exact
+	ICMMethod_ICM_MONTE_CARLO ICMMethod = 2 // Sampled Malmuth-Harville finishing orders
+)
+
+// Enum value maps for ICMMethod.
+var (
+	ICMMethod_name = map[int32]string{
+		0: "ICM_AUTO",
+		1: "ICM_EXACT",
+		2: "ICM_MONTE_CARLO",
+	}
+	ICMMethod_value = map[string]int32{
+		"ICM_AUTO":        0,
+		"ICM_EXACT":       1,
+		"ICM_MONTE_CARLO": 2,
+	}
+)
+
+func (x ICMMethod) Enum() *ICMMethod {
+	p := new(ICMMethod)
+	*p = x
+	return p
+}
+
+func (x ICMMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ICMMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_poker_proto_enumTypes[3].Descriptor()
+}
+
+func (ICMMethod) Type() protoreflect.EnumType {
+	return &file_proto_poker_proto_enumTypes[3]
+}
+
+func (x ICMMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ICMMethod.Descriptor instead.
+func (ICMMethod) EnumDescriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{3}
+}
+
 type HandRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	HoleCards      []string `protobuf:"bytes,1,rep,name=hole_cards,json=holeCards,proto3" json:"hole_cards,omitempty"`                // e.g. ["HA", "SK"]
-	CommunityCards []string `protobuf:"bytes,2,rep,name=community_cards,json=communityCards,proto3" json:"community_cards,omitempty"` // e.g. ["D2", "C7"...]
+	HoleCards      []string   `protobuf:"bytes,1,rep,name=hole_cards,json=holeCards,proto3" json:"hole_cards,omitempty"`                // e.g. ["HA", "SK"]
+	CommunityCards []string   `protobuf:"bytes,2,rep,name=community_cards,json=communityCards,proto3" json:"community_cards,omitempty"` // e.g. ["D2", "C7"...]
+	Variant        string     `protobuf:"bytes,3,opt,name=variant,proto3" json:"variant,omitempty"`                                     // "holdem" (default), "omaha", "short-deck", "razz" or "deuce-to-seven"
+	Wild           *WildCards `protobuf:"bytes,4,opt,name=wild,proto3" json:"wild,omitempty"`                                           // Wild cards, Hold'em only; jokers are written "JK1" and "JK2"
 }
 
 func (x *HandRequest) Reset() {
@@ -75,18 +279,32 @@ func (x *HandRequest) GetCommunityCards() []string {
 	return nil
 }
 
-type HandResponse struct {
+func (x *HandRequest) GetVariant() string {
+	if x != nil {
+		return x.Variant
+	}
+	return ""
+}
+
+func (x *HandRequest) GetWild() *WildCards {
+	if x != nil {
+		return x.Wild
+	}
+	return nil
+}
+
+type WildCards struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BestHandName  string   `protobuf:"bytes,1,opt,name=best_hand_name,json=bestHandName,proto3" json:"best_hand_name,omitempty"` // e.g. "Full House"
-	HandRankValue int32    `protobuf:"varint,2,opt,name=hand_rank_value,json=handRankValue,proto3" json:"hand_rank_value,omitempty"`
-	BestCards     []string `protobuf:"bytes,3,rep,name=best_cards,json=bestCards,proto3" json:"best_cards,omitempty"` // The actual 5 cards forming the best hand
+	Jokers   int32 `protobuf:"varint,1,opt,name=jokers,proto3" json:"jokers,omitempty"`                     // Jokers added to the deck, 0 to 2
+	Bug      bool  `protobuf:"varint,2,opt,name=bug,proto3" json:"bug,omitempty"`                           // Jokers only play as aces or to complete straights and flushes
+	WildRank int32 `protobuf:"varint,3,opt,name=wild_rank,json=wildRank,proto3" json:"wild_rank,omitempty"` // Every card of this rank is wild, e.g. 2 for deuces wild
 }
 
-func (x *HandResponse) Reset() {
-	*x = HandResponse{}
+func (x *WildCards) Reset() {
+	*x = WildCards{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_poker_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -94,13 +312,13 @@ func (x *HandResponse) Reset() {
 	}
 }
 
-func (x *HandResponse) String() string {
+func (x *WildCards) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HandResponse) ProtoMessage() {}
+func (*WildCards) ProtoMessage() {}
 
-func (x *HandResponse) ProtoReflect() protoreflect.Message {
+func (x *WildCards) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_poker_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -112,43 +330,43 @@ func (x *HandResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HandResponse.ProtoReflect.Descriptor instead.
-func (*HandResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WildCards.ProtoReflect.Descriptor instead.
+func (*WildCards) Descriptor() ([]byte, []int) {
 	return file_proto_poker_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *HandResponse) GetBestHandName() string {
+func (x *WildCards) GetJokers() int32 {
 	if x != nil {
-		return x.BestHandName
+		return x.Jokers
 	}
-	return ""
+	return 0
 }
 
-func (x *HandResponse) GetHandRankValue() int32 {
+func (x *WildCards) GetBug() bool {
 	if x != nil {
-		return x.HandRankValue
+		return x.Bug
 	}
-	return 0
+	return false
 }
 
-func (x *HandResponse) GetBestCards() []string {
+func (x *WildCards) GetWildRank() int32 {
 	if x != nil {
-		return x.BestCards
+		return x.WildRank
 	}
-	return nil
+	return 0
 }
 
-type CompareRequest struct {
+type Substitution struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Hand1 *HandRequest `protobuf:"bytes,1,opt,name=hand1,proto3" json:"hand1,omitempty"`
-	Hand2 *HandRequest `protobuf:"bytes,2,opt,name=hand2,proto3" json:"hand2,omitempty"`
+	WildCard string `protobuf:"bytes,1,opt,name=wild_card,json=wildCard,proto3" json:"wild_card,omitempty"` // e.g. "JK1" or "S2"
+	PlaysAs  string `protobuf:"bytes,2,opt,name=plays_as,json=playsAs,proto3" json:"plays_as,omitempty"`    // The card it stands for, e.g. "HA"
 }
 
-func (x *CompareRequest) Reset() {
-	*x = CompareRequest{}
+func (x *Substitution) Reset() {
+	*x = Substitution{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_poker_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -156,13 +374,13 @@ func (x *CompareRequest) Reset() {
 	}
 }
 
-func (x *CompareRequest) String() string {
+func (x *Substitution) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CompareRequest) ProtoMessage() {}
+func (*Substitution) ProtoMessage() {}
 
-func (x *CompareRequest) ProtoReflect() protoreflect.Message {
+func (x *Substitution) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_poker_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -174,37 +392,45 @@ func (x *CompareRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CompareRequest.ProtoReflect.Descriptor instead.
-func (*CompareRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Substitution.ProtoReflect.Descriptor instead.
+func (*Substitution) Descriptor() ([]byte, []int) {
 	return file_proto_poker_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *CompareRequest) GetHand1() *HandRequest {
+func (x *Substitution) GetWildCard() string {
 	if x != nil {
-		return x.Hand1
+		return x.WildCard
 	}
-	return nil
+	return ""
 }
 
-func (x *CompareRequest) GetHand2() *HandRequest {
+func (x *Substitution) GetPlaysAs() string {
 	if x != nil {
-		return x.Hand2
+		return x.PlaysAs
 	}
-	return nil
+	return ""
 }
 
-type CompareResponse struct {
+type HandResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Winner      int32         `protobuf:"varint,1,opt,name=winner,proto3" json:"winner,omitempty"` // 1 for hand1, 2 for hand2, 0 for tie
-	Hand1Result *HandResponse `protobuf:"bytes,2,opt,name=hand1_result,json=hand1Result,proto3" json:"hand1_result,omitempty"`
-	Hand2Result *HandResponse `protobuf:"bytes,3,opt,name=hand2_result,json=hand2Result,proto3" json:"hand2_result,omitempty"`
+	BestHandName         string          `protobuf:"bytes,1,opt,name=best_hand_name,json=bestHandName,proto3" json:"best_hand_name,omitempty"` // e.g. "Full House"
+	HandRankValue        int32           `protobuf:"varint,2,opt,name=hand_rank_value,json=handRankValue,proto3" json:"hand_rank_value,omitempty"`
+	BestCards            []string        `protobuf:"bytes,3,rep,name=best_cards,json=bestCards,proto3" json:"best_cards,omitempty"`                                      // The actual 5 cards forming the best hand
+	Draws                []*Draw         `protobuf:"bytes,4,rep,name=draws,proto3" json:"draws,omitempty"`                                                               // Draws held while community cards are still to come
+	EquivalenceClass     int32           `protobuf:"varint,5,opt,name=equivalence_class,json=equivalenceClass,proto3" json:"equivalence_class,omitempty"`                // 1 (royal flush) to 7462 (7-5-4-3-2 offsuit)
+	FiveCardProbability  float64         `protobuf:"fixed64,6,opt,name=five_card_probability,json=fiveCardProbability,proto3" json:"five_card_probability,omitempty"`    // Chance of this category in a 5-card hand
+	SevenCardProbability float64         `protobuf:"fixed64,7,opt,name=seven_card_probability,json=sevenCardProbability,proto3" json:"seven_card_probability,omitempty"` // Chance of this category in a 7-card hand
+	Percentile           float64         `protobuf:"fixed64,8,opt,name=percentile,proto3" json:"percentile,omitempty"`                                                   // Share of holdings on the board beaten (ties half), of starting hands preflop, or of all 5-card hands
+	HandLabel            string          `protobuf:"bytes,9,opt,name=hand_label,json=handLabel,proto3" json:"hand_label,omitempty"`                                      // e.g. "Pocket Aces", "Top Pair, Top Kicker", "Set", "Nut Flush"
+	HoleCardsUsed        int32           `protobuf:"varint,10,opt,name=hole_cards_used,json=holeCardsUsed,proto3" json:"hole_cards_used,omitempty"`                      // Hole cards among best_cards: 0 when the board plays
+	Substitutions        []*Substitution `protobuf:"bytes,11,rep,name=substitutions,proto3" json:"substitutions,omitempty"`                                              // What each wild card in best_cards plays as
 }
 
-func (x *CompareResponse) Reset() {
-	*x = CompareResponse{}
+func (x *HandResponse) Reset() {
+	*x = HandResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_poker_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -212,13 +438,13 @@ func (x *CompareResponse) Reset() {
 	}
 }
 
-func (x *CompareResponse) String() string {
+func (x *HandResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CompareResponse) ProtoMessage() {}
+func (*HandResponse) ProtoMessage() {}
 
-func (x *CompareResponse) ProtoReflect() protoreflect.Message {
+func (x *HandResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_poker_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -230,44 +456,99 @@ func (x *CompareResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CompareResponse.ProtoReflect.Descriptor instead.
-func (*CompareResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use HandResponse.ProtoReflect.Descriptor instead.
+func (*HandResponse) Descriptor() ([]byte, []int) {
 	return file_proto_poker_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *CompareResponse) GetWinner() int32 {
+func (x *HandResponse) GetBestHandName() string {
 	if x != nil {
-		return x.Winner
+		return x.BestHandName
+	}
+	return ""
+}
+
+func (x *HandResponse) GetHandRankValue() int32 {
+	if x != nil {
+		return x.HandRankValue
 	}
 	return 0
 }
 
-func (x *CompareResponse) GetHand1Result() *HandResponse {
+func (x *HandResponse) GetBestCards() []string {
 	if x != nil {
-		return x.Hand1Result
+		return x.BestCards
 	}
 	return nil
 }
 
-func (x *CompareResponse) GetHand2Result() *HandResponse {
+func (x *HandResponse) GetDraws() []*Draw {
 	if x != nil {
-		return x.Hand2Result
+		return x.Draws
 	}
 	return nil
 }
 
-type SimRequest struct {
+func (x *HandResponse) GetEquivalenceClass() int32 {
+	if x != nil {
+		return x.EquivalenceClass
+	}
+	return 0
+}
+
+func (x *HandResponse) GetFiveCardProbability() float64 {
+	if x != nil {
+		return x.FiveCardProbability
+	}
+	return 0
+}
+
+func (x *HandResponse) GetSevenCardProbability() float64 {
+	if x != nil {
+		return x.SevenCardProbability
+	}
+	return 0
+}
+
+func (x *HandResponse) GetPercentile() float64 {
+	if x != nil {
+		return x.Percentile
+	}
+	return 0
+}
+
+func (x *HandResponse) GetHandLabel() string {
+	if x != nil {
+		return x.HandLabel
+	}
+	return ""
+}
+
+func (x *HandResponse) GetHoleCardsUsed() int32 {
+	if x != nil {
+		return x.HoleCardsUsed
+	}
+	return 0
+}
+
+func (x *HandResponse) GetSubstitutions() []*Substitution {
+	if x != nil {
+		return x.Substitutions
+	}
+	return nil
+}
+
+type Draw struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	HoleCards      []string `protobuf:"bytes,1,rep,name=hole_cards,json=holeCards,proto3" json:"hole_cards,omitempty"`                 // e.g. ["HA", "SK"]
-	CommunityCards []string `protobuf:"bytes,2,rep,name=community_cards,json=communityCards,proto3" json:"community_cards,omitempty"`  // Known community cards
-	NumSimulations int32    `protobuf:"varint,3,opt,name=num_simulations,json=numSimulations,proto3" json:"num_simulations,omitempty"` // Number of Monte Carlo simulations
+	Name string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // e.g. "Flush Draw", "Gutshot"
+	Outs []string `protobuf:"bytes,2,rep,name=outs,proto3" json:"outs,omitempty"` // Unseen cards that complete the draw
 }
 
-func (x *SimRequest) Reset() {
-	*x = SimRequest{}
+func (x *Draw) Reset() {
+	*x = Draw{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_poker_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -275,13 +556,13 @@ func (x *SimRequest) Reset() {
 	}
 }
 
-func (x *SimRequest) String() string {
+func (x *Draw) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SimRequest) ProtoMessage() {}
+func (*Draw) ProtoMessage() {}
 
-func (x *SimRequest) ProtoReflect() protoreflect.Message {
+func (x *Draw) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_poker_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -293,45 +574,36 @@ func (x *SimRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SimRequest.ProtoReflect.Descriptor instead.
-func (*SimRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Draw.ProtoReflect.Descriptor instead.
+func (*Draw) Descriptor() ([]byte, []int) {
 	return file_proto_poker_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *SimRequest) GetHoleCards() []string {
+func (x *Draw) GetName() string {
 	if x != nil {
-		return x.HoleCards
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *SimRequest) GetCommunityCards() []string {
+func (x *Draw) GetOuts() []string {
 	if x != nil {
-		return x.CommunityCards
+		return x.Outs
 	}
 	return nil
 }
 
-func (x *SimRequest) GetNumSimulations() int32 {
-	if x != nil {
-		return x.NumSimulations
-	}
-	return 0
-}
-
-type SimResponse struct {
+type CompareRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	WinProbability  float64 `protobuf:"fixed64,1,opt,name=win_probability,json=winProbability,proto3" json:"win_probability,omitempty"`
-	TieProbability  float64 `protobuf:"fixed64,2,opt,name=tie_probability,json=tieProbability,proto3" json:"tie_probability,omitempty"`
-	LoseProbability float64 `protobuf:"fixed64,3,opt,name=lose_probability,json=loseProbability,proto3" json:"lose_probability,omitempty"`
-	SimulationsRun  int32   `protobuf:"varint,4,opt,name=simulations_run,json=simulationsRun,proto3" json:"simulations_run,omitempty"`
+	Hand1 *HandRequest `protobuf:"bytes,1,opt,name=hand1,proto3" json:"hand1,omitempty"`
+	Hand2 *HandRequest `protobuf:"bytes,2,opt,name=hand2,proto3" json:"hand2,omitempty"`
 }
 
-func (x *SimResponse) Reset() {
-	*x = SimResponse{}
+func (x *CompareRequest) Reset() {
+	*x = CompareRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_poker_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -339,13 +611,13 @@ func (x *SimResponse) Reset() {
 	}
 }
 
-func (x *SimResponse) String() string {
+func (x *CompareRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SimResponse) ProtoMessage() {}
+func (*CompareRequest) ProtoMessage() {}
 
-func (x *SimResponse) ProtoReflect() protoreflect.Message {
+func (x *CompareRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_poker_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -357,155 +629,6116 @@ func (x *SimResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SimResponse.ProtoReflect.Descriptor instead.
-func (*SimResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CompareRequest.ProtoReflect.Descriptor instead.
+func (*CompareRequest) Descriptor() ([]byte, []int) {
 	return file_proto_poker_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *SimResponse) GetWinProbability() float64 {
+func (x *CompareRequest) GetHand1() *HandRequest {
 	if x != nil {
-		return x.WinProbability
+		return x.Hand1
 	}
-	return 0
+	return nil
 }
 
-func (x *SimResponse) GetTieProbability() float64 {
+func (x *CompareRequest) GetHand2() *HandRequest {
 	if x != nil {
-		return x.TieProbability
+		return x.Hand2
+	}
+	return nil
+}
+
+type CompareResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Winner      int32         `protobuf:"varint,1,opt,name=winner,proto3" json:"winner,omitempty"` // 1 for hand1, 2 for hand2, 0 for tie
+	Hand1Result *HandResponse `protobuf:"bytes,2,opt,name=hand1_result,json=hand1Result,proto3" json:"hand1_result,omitempty"`
+	Hand2Result *HandResponse `protobuf:"bytes,3,opt,name=hand2_result,json=hand2Result,proto3" json:"hand2_result,omitempty"`
+	TieBreak    *TieBreak     `protobuf:"bytes,4,opt,name=tie_break,json=tieBreak,proto3" json:"tie_break,omitempty"` // How the winner was decided
+}
+
+func (x *CompareResponse) Reset() {
+	*x = CompareResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompareResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareResponse) ProtoMessage() {}
+
+func (x *CompareResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareResponse.ProtoReflect.Descriptor instead.
+func (*CompareResponse) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CompareResponse) GetWinner() int32 {
+	if x != nil {
+		return x.Winner
 	}
 	return 0
 }
 
-func (x *SimResponse) GetLoseProbability() float64 {
+func (x *CompareResponse) GetHand1Result() *HandResponse {
 	if x != nil {
-		return x.LoseProbability
+		return x.Hand1Result
+	}
+	return nil
+}
+
+func (x *CompareResponse) GetHand2Result() *HandResponse {
+	if x != nil {
+		return x.Hand2Result
+	}
+	return nil
+}
+
+func (x *CompareResponse) GetTieBreak() *TieBreak {
+	if x != nil {
+		return x.TieBreak
+	}
+	return nil
+}
+
+type TieBreak struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Level           string   `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`                             // "Category", "Primary Rank", "Secondary Rank", "Kicker" or "Tie"
+	Kicker          int32    `protobuf:"varint,2,opt,name=kicker,proto3" json:"kicker,omitempty"`                          // 1-based kicker that decided, 0 otherwise
+	Hand1Cards      []string `protobuf:"bytes,3,rep,name=hand1_cards,json=hand1Cards,proto3" json:"hand1_cards,omitempty"` // Hand 1's cards at the deciding level
+	Hand2Cards      []string `protobuf:"bytes,4,rep,name=hand2_cards,json=hand2Cards,proto3" json:"hand2_cards,omitempty"`
+	BoardPlays      bool     `protobuf:"varint,5,opt,name=board_plays,json=boardPlays,proto3" json:"board_plays,omitempty"` // Both best hands are made of community cards only
+	Hand1BoardPlays bool     `protobuf:"varint,6,opt,name=hand1_board_plays,json=hand1BoardPlays,proto3" json:"hand1_board_plays,omitempty"`
+	Hand2BoardPlays bool     `protobuf:"varint,7,opt,name=hand2_board_plays,json=hand2BoardPlays,proto3" json:"hand2_board_plays,omitempty"`
+	Explanation     string   `protobuf:"bytes,8,opt,name=explanation,proto3" json:"explanation,omitempty"` // e.g. "Both have One Pair; hand 1 wins on the second kicker: 9 beats 7"
+}
+
+func (x *TieBreak) Reset() {
+	*x = TieBreak{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TieBreak) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TieBreak) ProtoMessage() {}
+
+func (x *TieBreak) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TieBreak.ProtoReflect.Descriptor instead.
+func (*TieBreak) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TieBreak) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *TieBreak) GetKicker() int32 {
+	if x != nil {
+		return x.Kicker
 	}
 	return 0
 }
 
-func (x *SimResponse) GetSimulationsRun() int32 {
+func (x *TieBreak) GetHand1Cards() []string {
 	if x != nil {
-		return x.SimulationsRun
+		return x.Hand1Cards
+	}
+	return nil
+}
+
+func (x *TieBreak) GetHand2Cards() []string {
+	if x != nil {
+		return x.Hand2Cards
+	}
+	return nil
+}
+
+func (x *TieBreak) GetBoardPlays() bool {
+	if x != nil {
+		return x.BoardPlays
+	}
+	return false
+}
+
+func (x *TieBreak) GetHand1BoardPlays() bool {
+	if x != nil {
+		return x.Hand1BoardPlays
+	}
+	return false
+}
+
+func (x *TieBreak) GetHand2BoardPlays() bool {
+	if x != nil {
+		return x.Hand2BoardPlays
+	}
+	return false
+}
+
+func (x *TieBreak) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+type SimRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HoleCards      []string   `protobuf:"bytes,1,rep,name=hole_cards,json=holeCards,proto3" json:"hole_cards,omitempty"`                 // e.g. ["HA", "SK"]
+	CommunityCards []string   `protobuf:"bytes,2,rep,name=community_cards,json=communityCards,proto3" json:"community_cards,omitempty"`  // Known community cards
+	NumSimulations int32      `protobuf:"varint,3,opt,name=num_simulations,json=numSimulations,proto3" json:"num_simulations,omitempty"` // Number of Monte Carlo simulations
+	Estimator      Estimator  `protobuf:"varint,4,opt,name=estimator,proto3,enum=poker.Estimator" json:"estimator,omitempty"`            // Variance reduction technique, naive by default
+	Variant        string     `protobuf:"bytes,5,opt,name=variant,proto3" json:"variant,omitempty"`                                      // As in HandRequest; estimators other than naive are Hold'em only
+	Wild           *WildCards `protobuf:"bytes,6,opt,name=wild,proto3" json:"wild,omitempty"`                                            // Deal from a deck with these wild cards, naive estimator only
+}
+
+func (x *SimRequest) Reset() {
+	*x = SimRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimRequest) ProtoMessage() {}
+
+func (x *SimRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimRequest.ProtoReflect.Descriptor instead.
+func (*SimRequest) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SimRequest) GetHoleCards() []string {
+	if x != nil {
+		return x.HoleCards
+	}
+	return nil
+}
+
+func (x *SimRequest) GetCommunityCards() []string {
+	if x != nil {
+		return x.CommunityCards
+	}
+	return nil
+}
+
+func (x *SimRequest) GetNumSimulations() int32 {
+	if x != nil {
+		return x.NumSimulations
 	}
 	return 0
 }
 
-var File_proto_poker_proto protoreflect.FileDescriptor
+func (x *SimRequest) GetEstimator() Estimator {
+	if x != nil {
+		return x.Estimator
+	}
+	return Estimator_ESTIMATOR_NAIVE
+}
 
-var file_proto_poker_proto_rawDesc = []byte{
-	0x0a, 0x11, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x22, 0x55, 0x0a, 0x0b, 0x48, 0x61,
-	0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x6f, 0x6c,
-	0x65, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x68,
-	0x6f, 0x6c, 0x65, 0x43, 0x61, 0x72, 0x64, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x6d,
-	0x75, 0x6e, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x43, 0x61, 0x72, 0x64,
-	0x73, 0x22, 0x7b, 0x0a, 0x0c, 0x48, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x24, 0x0a, 0x0e, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x61, 0x6e, 0x64, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x65, 0x73, 0x74, 0x48,
-	0x61, 0x6e, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x68, 0x61, 0x6e, 0x64, 0x5f,
-	0x72, 0x61, 0x6e, 0x6b, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x0d, 0x68, 0x61, 0x6e, 0x64, 0x52, 0x61, 0x6e, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
-	0x1d, 0x0a, 0x0a, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x09, 0x62, 0x65, 0x73, 0x74, 0x43, 0x61, 0x72, 0x64, 0x73, 0x22, 0x64,
-	0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x28, 0x0a, 0x05, 0x68, 0x61, 0x6e, 0x64, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x12, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x52, 0x05, 0x68, 0x61, 0x6e, 0x64, 0x31, 0x12, 0x28, 0x0a, 0x05, 0x68, 0x61,
-	0x6e, 0x64, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x6f, 0x6b, 0x65,
-	0x72, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x05, 0x68,
-	0x61, 0x6e, 0x64, 0x32, 0x22, 0x99, 0x01, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x6e,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72,
-	0x12, 0x36, 0x0a, 0x0c, 0x68, 0x61, 0x6e, 0x64, 0x31, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x48,
-	0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0b, 0x68, 0x61, 0x6e,
-	0x64, 0x31, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x36, 0x0a, 0x0c, 0x68, 0x61, 0x6e, 0x64,
-	0x32, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
-	0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x52, 0x0b, 0x68, 0x61, 0x6e, 0x64, 0x32, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x22, 0x7d, 0x0a, 0x0a, 0x53, 0x69, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
-	0x0a, 0x0a, 0x68, 0x6f, 0x6c, 0x65, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x09, 0x68, 0x6f, 0x6c, 0x65, 0x43, 0x61, 0x72, 0x64, 0x73, 0x12, 0x27, 0x0a,
-	0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74,
-	0x79, 0x43, 0x61, 0x72, 0x64, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x6e, 0x75, 0x6d, 0x5f, 0x73, 0x69,
-	0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0e, 0x6e, 0x75, 0x6d, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
-	0xb3, 0x01, 0x0a, 0x0b, 0x53, 0x69, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x27, 0x0a, 0x0f, 0x77, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69,
-	0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x77, 0x69, 0x6e, 0x50, 0x72, 0x6f,
-	0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x69, 0x65, 0x5f,
-	0x70, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x0e, 0x74, 0x69, 0x65, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
-	0x79, 0x12, 0x29, 0x0a, 0x10, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x61, 0x62,
-	0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x6c, 0x6f, 0x73,
-	0x65, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x27, 0x0a, 0x0f,
-	0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x72, 0x75, 0x6e, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x52, 0x75, 0x6e, 0x32, 0xc5, 0x01, 0x0a, 0x0c, 0x50, 0x6f, 0x6b, 0x65, 0x72, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x37, 0x0a, 0x0c, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61,
-	0x74, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x12, 0x12, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x48,
-	0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x6f, 0x6b,
-	0x65, 0x72, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x3d, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x73, 0x12,
-	0x15, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x43,
-	0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d,
-	0x0a, 0x14, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x62, 0x61,
-	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x11, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x53,
-	0x69, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70, 0x6f, 0x6b, 0x65,
-	0x72, 0x2e, 0x53, 0x69, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x09, 0x5a,
-	0x07, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *SimRequest) GetVariant() string {
+	if x != nil {
+		return x.Variant
+	}
+	return ""
 }
 
-var (
-	file_proto_poker_proto_rawDescOnce sync.Once
-	file_proto_poker_proto_rawDescData = file_proto_poker_proto_rawDesc
-)
+func (x *SimRequest) GetWild() *WildCards {
+	if x != nil {
+		return x.Wild
+	}
+	return nil
+}
 
-func file_proto_poker_proto_rawDescGZIP() []byte {
-	file_proto_poker_proto_rawDescOnce.Do(func() {
-		file_proto_poker_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_poker_proto_rawDescData)
-	})
-	return file_proto_poker_proto_rawDescData
+type SimResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WinProbability  float64   `protobuf:"fixed64,1,opt,name=win_probability,json=winProbability,proto3" json:"win_probability,omitempty"`
+	TieProbability  float64   `protobuf:"fixed64,2,opt,name=tie_probability,json=tieProbability,proto3" json:"tie_probability,omitempty"`
+	LoseProbability float64   `protobuf:"fixed64,3,opt,name=lose_probability,json=loseProbability,proto3" json:"lose_probability,omitempty"`
+	SimulationsRun  int32     `protobuf:"varint,4,opt,name=simulations_run,json=simulationsRun,proto3" json:"simulations_run,omitempty"`
+	EstimatorUsed   Estimator `protobuf:"varint,5,opt,name=estimator_used,json=estimatorUsed,proto3,enum=poker.Estimator" json:"estimator_used,omitempty"`
+	StandardError   float64   `protobuf:"fixed64,6,opt,name=standard_error,json=standardError,proto3" json:"standard_error,omitempty"` // Standard error of the equity (win + tie/2) estimate
 }
 
-var file_proto_poker_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
-var file_proto_poker_proto_goTypes = []interface{}{
-	(*HandRequest)(nil),     // 0: poker.HandRequest
-	(*HandResponse)(nil),    // 1: poker.HandResponse
-	(*CompareRequest)(nil),  // 2: poker.CompareRequest
-	(*CompareResponse)(nil), // 3: poker.CompareResponse
-	(*SimRequest)(nil),      // 4: poker.SimRequest
-	(*SimResponse)(nil),     // 5: poker.SimResponse
+func (x *SimResponse) Reset() {
+	*x = SimResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-var file_proto_poker_proto_depIdxs = []int32{
-	0, // 0: poker.CompareRequest.hand1:type_name -> poker.HandRequest
-	0, // 1: poker.CompareRequest.hand2:type_name -> poker.HandRequest
-	1, // 2: poker.CompareResponse.hand1_result:type_name -> poker.HandResponse
-	1, // 3: poker.CompareResponse.hand2_result:type_name -> poker.HandResponse
-	0, // 4: poker.PokerService.EvaluateHand:input_type -> poker.HandRequest
-	2, // 5: poker.PokerService.CompareHands:input_type -> poker.CompareRequest
-	4, // 6: poker.PokerService.CalculateProbability:input_type -> poker.SimRequest
-	1, // 7: poker.PokerService.EvaluateHand:output_type -> poker.HandResponse
-	3, // 8: poker.PokerService.CompareHands:output_type -> poker.CompareResponse
-	5, // 9: poker.PokerService.CalculateProbability:output_type -> poker.SimResponse
-	7, // [7:10] is the sub-list for method output_type
-	4, // [4:7] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+
+func (x *SimResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func init() { file_proto_poker_proto_init() }
-func file_proto_poker_proto_init() {
-	if File_proto_poker_proto != nil {
-		return
+func (*SimResponse) ProtoMessage() {}
+
+func (x *SimResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_proto_poker_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HandRequest); i {
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimResponse.ProtoReflect.Descriptor instead.
+func (*SimResponse) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SimResponse) GetWinProbability() float64 {
+	if x != nil {
+		return x.WinProbability
+	}
+	return 0
+}
+
+func (x *SimResponse) GetTieProbability() float64 {
+	if x != nil {
+		return x.TieProbability
+	}
+	return 0
+}
+
+func (x *SimResponse) GetLoseProbability() float64 {
+	if x != nil {
+		return x.LoseProbability
+	}
+	return 0
+}
+
+func (x *SimResponse) GetSimulationsRun() int32 {
+	if x != nil {
+		return x.SimulationsRun
+	}
+	return 0
+}
+
+func (x *SimResponse) GetEstimatorUsed() Estimator {
+	if x != nil {
+		return x.EstimatorUsed
+	}
+	return Estimator_ESTIMATOR_NAIVE
+}
+
+func (x *SimResponse) GetStandardError() float64 {
+	if x != nil {
+		return x.StandardError
+	}
+	return 0
+}
+
+type HandStrengthRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HoleCards      []string `protobuf:"bytes,1,rep,name=hole_cards,json=holeCards,proto3" json:"hole_cards,omitempty"`                // e.g. ["HA", "SK"]
+	CommunityCards []string `protobuf:"bytes,2,rep,name=community_cards,json=communityCards,proto3" json:"community_cards,omitempty"` // Flop or turn, 3 or 4 cards
+	NumOpponents   int32    `protobuf:"varint,3,opt,name=num_opponents,json=numOpponents,proto3" json:"num_opponents,omitempty"`      // Defaults to 1
+}
+
+func (x *HandStrengthRequest) Reset() {
+	*x = HandStrengthRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HandStrengthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandStrengthRequest) ProtoMessage() {}
+
+func (x *HandStrengthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandStrengthRequest.ProtoReflect.Descriptor instead.
+func (*HandStrengthRequest) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *HandStrengthRequest) GetHoleCards() []string {
+	if x != nil {
+		return x.HoleCards
+	}
+	return nil
+}
+
+func (x *HandStrengthRequest) GetCommunityCards() []string {
+	if x != nil {
+		return x.CommunityCards
+	}
+	return nil
+}
+
+func (x *HandStrengthRequest) GetNumOpponents() int32 {
+	if x != nil {
+		return x.NumOpponents
+	}
+	return 0
+}
+
+type HandStrengthResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HandStrength           float64 `protobuf:"fixed64,1,opt,name=hand_strength,json=handStrength,proto3" json:"hand_strength,omitempty"`                                 // Fraction of opponent combos beaten now (ties count half)
+	PositivePotential      float64 `protobuf:"fixed64,2,opt,name=positive_potential,json=positivePotential,proto3" json:"positive_potential,omitempty"`                  // PPot: chance of getting ahead on the next card
+	NegativePotential      float64 `protobuf:"fixed64,3,opt,name=negative_potential,json=negativePotential,proto3" json:"negative_potential,omitempty"`                  // NPot: chance of falling behind on the next card
+	EffectiveHandStrength  float64 `protobuf:"fixed64,4,opt,name=effective_hand_strength,json=effectiveHandStrength,proto3" json:"effective_hand_strength,omitempty"`    // EHS = HS_n * (1 - NPot) + (1 - HS_n) * PPot
+	OptimisticHandStrength float64 `protobuf:"fixed64,5,opt,name=optimistic_hand_strength,json=optimisticHandStrength,proto3" json:"optimistic_hand_strength,omitempty"` // EHS' = HS_n + (1 - HS_n) * PPot
+	CombosAhead            int32   `protobuf:"varint,6,opt,name=combos_ahead,json=combosAhead,proto3" json:"combos_ahead,omitempty"`
+	CombosTied             int32   `protobuf:"varint,7,opt,name=combos_tied,json=combosTied,proto3" json:"combos_tied,omitempty"`
+	CombosBehind           int32   `protobuf:"varint,8,opt,name=combos_behind,json=combosBehind,proto3" json:"combos_behind,omitempty"`
+}
+
+func (x *HandStrengthResponse) Reset() {
+	*x = HandStrengthResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HandStrengthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandStrengthResponse) ProtoMessage() {}
+
+func (x *HandStrengthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandStrengthResponse.ProtoReflect.Descriptor instead.
+func (*HandStrengthResponse) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *HandStrengthResponse) GetHandStrength() float64 {
+	if x != nil {
+		return x.HandStrength
+	}
+	return 0
+}
+
+func (x *HandStrengthResponse) GetPositivePotential() float64 {
+	if x != nil {
+		return x.PositivePotential
+	}
+	return 0
+}
+
+func (x *HandStrengthResponse) GetNegativePotential() float64 {
+	if x != nil {
+		return x.NegativePotential
+	}
+	return 0
+}
+
+func (x *HandStrengthResponse) GetEffectiveHandStrength() float64 {
+	if x != nil {
+		return x.EffectiveHandStrength
+	}
+	return 0
+}
+
+func (x *HandStrengthResponse) GetOptimisticHandStrength() float64 {
+	if x != nil {
+		return x.OptimisticHandStrength
+	}
+	return 0
+}
+
+func (x *HandStrengthResponse) GetCombosAhead() int32 {
+	if x != nil {
+		return x.CombosAhead
+	}
+	return 0
+}
+
+func (x *HandStrengthResponse) GetCombosTied() int32 {
+	if x != nil {
+		return x.CombosTied
+	}
+	return 0
+}
+
+func (x *HandStrengthResponse) GetCombosBehind() int32 {
+	if x != nil {
+		return x.CombosBehind
+	}
+	return 0
+}
+
+type BoardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommunityCards []string `protobuf:"bytes,1,rep,name=community_cards,json=communityCards,proto3" json:"community_cards,omitempty"` // Flop, turn or river, 3 to 5 cards
+}
+
+func (x *BoardRequest) Reset() {
+	*x = BoardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardRequest) ProtoMessage() {}
+
+func (x *BoardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardRequest.ProtoReflect.Descriptor instead.
+func (*BoardRequest) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BoardRequest) GetCommunityCards() []string {
+	if x != nil {
+		return x.CommunityCards
+	}
+	return nil
+}
+
+type BoardTextureResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pairing          string       `protobuf:"bytes,1,opt,name=pairing,proto3" json:"pairing,omitempty"` // Hand made by the board alone, e.g. "One Pair"
+	Paired           bool         `protobuf:"varint,2,opt,name=paired,proto3" json:"paired,omitempty"`
+	Trips            bool         `protobuf:"varint,3,opt,name=trips,proto3" json:"trips,omitempty"`
+	SuitPattern      string       `protobuf:"bytes,4,opt,name=suit_pattern,json=suitPattern,proto3" json:"suit_pattern,omitempty"` // "Monotone", "Two-Tone" or "Rainbow"
+	MaxSuitCount     int32        `protobuf:"varint,5,opt,name=max_suit_count,json=maxSuitCount,proto3" json:"max_suit_count,omitempty"`
+	FlushPossible    bool         `protobuf:"varint,6,opt,name=flush_possible,json=flushPossible,proto3" json:"flush_possible,omitempty"`
+	Connectivity     string       `protobuf:"bytes,7,opt,name=connectivity,proto3" json:"connectivity,omitempty"`                               // "Connected", "Semi-Connected" or "Disconnected"
+	StraightWindows  int32        `protobuf:"varint,8,opt,name=straight_windows,json=straightWindows,proto3" json:"straight_windows,omitempty"` // Number of distinct straights a holding can make
+	StraightPossible bool         `protobuf:"varint,9,opt,name=straight_possible,json=straightPossible,proto3" json:"straight_possible,omitempty"`
+	HighCard         string       `protobuf:"bytes,10,opt,name=high_card,json=highCard,proto3" json:"high_card,omitempty"`                // e.g. "A"
+	Wetness          int32        `protobuf:"varint,11,opt,name=wetness,proto3" json:"wetness,omitempty"`                                 // 0 (dry) to 100 (wet)
+	PossibleHands    []*HandCount `protobuf:"bytes,12,rep,name=possible_hands,json=possibleHands,proto3" json:"possible_hands,omitempty"` // Made hands the board allows, best first
+	PossibleDraws    []*HandCount `protobuf:"bytes,13,rep,name=possible_draws,json=possibleDraws,proto3" json:"possible_draws,omitempty"` // Draws the board allows
+}
+
+func (x *BoardTextureResponse) Reset() {
+	*x = BoardTextureResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoardTextureResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoardTextureResponse) ProtoMessage() {}
+
+func (x *BoardTextureResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoardTextureResponse.ProtoReflect.Descriptor instead.
+func (*BoardTextureResponse) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *BoardTextureResponse) GetPairing() string {
+	if x != nil {
+		return x.Pairing
+	}
+	return ""
+}
+
+func (x *BoardTextureResponse) GetPaired() bool {
+	if x != nil {
+		return x.Paired
+	}
+	return false
+}
+
+func (x *BoardTextureResponse) GetTrips() bool {
+	if x != nil {
+		return x.Trips
+	}
+	return false
+}
+
+func (x *BoardTextureResponse) GetSuitPattern() string {
+	if x != nil {
+		return x.SuitPattern
+	}
+	return ""
+}
+
+func (x *BoardTextureResponse) GetMaxSuitCount() int32 {
+	if x != nil {
+		return x.MaxSuitCount
+	}
+	return 0
+}
+
+func (x *BoardTextureResponse) GetFlushPossible() bool {
+	if x != nil {
+		return x.FlushPossible
+	}
+	return false
+}
+
+func (x *BoardTextureResponse) GetConnectivity() string {
+	if x != nil {
+		return x.Connectivity
+	}
+	return ""
+}
+
+func (x *BoardTextureResponse) GetStraightWindows() int32 {
+	if x != nil {
+		return x.StraightWindows
+	}
+	return 0
+}
+
+func (x *BoardTextureResponse) GetStraightPossible() bool {
+	if x != nil {
+		return x.StraightPossible
+	}
+	return false
+}
+
+func (x *BoardTextureResponse) GetHighCard() string {
+	if x != nil {
+		return x.HighCard
+	}
+	return ""
+}
+
+func (x *BoardTextureResponse) GetWetness() int32 {
+	if x != nil {
+		return x.Wetness
+	}
+	return 0
+}
+
+func (x *BoardTextureResponse) GetPossibleHands() []*HandCount {
+	if x != nil {
+		return x.PossibleHands
+	}
+	return nil
+}
+
+func (x *BoardTextureResponse) GetPossibleDraws() []*HandCount {
+	if x != nil {
+		return x.PossibleDraws
+	}
+	return nil
+}
+
+type HandCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`      // Hand or draw name
+	Combos int32  `protobuf:"varint,2,opt,name=combos,proto3" json:"combos,omitempty"` // Number of two-card holdings that make it
+}
+
+func (x *HandCount) Reset() {
+	*x = HandCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HandCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandCount) ProtoMessage() {}
+
+func (x *HandCount) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandCount.ProtoReflect.Descriptor instead.
+func (*HandCount) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *HandCount) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HandCount) GetCombos() int32 {
+	if x != nil {
+		return x.Combos
+	}
+	return 0
+}
+
+type NutsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommunityCards []string `protobuf:"bytes,1,rep,name=community_cards,json=communityCards,proto3" json:"community_cards,omitempty"` // Flop, turn or river, 3 to 5 cards
+	HoleCards      []string `protobuf:"bytes,2,rep,name=hole_cards,json=holeCards,proto3" json:"hole_cards,omitempty"`                // Optional hero hand, removed from opponent holdings
+	MaxClasses     int32    `protobuf:"varint,3,opt,name=max_classes,json=maxClasses,proto3" json:"max_classes,omitempty"`            // Hand classes to list, default 10
+}
+
+func (x *NutsRequest) Reset() {
+	*x = NutsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NutsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NutsRequest) ProtoMessage() {}
+
+func (x *NutsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NutsRequest.ProtoReflect.Descriptor instead.
+func (*NutsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *NutsRequest) GetCommunityCards() []string {
+	if x != nil {
+		return x.CommunityCards
+	}
+	return nil
+}
+
+func (x *NutsRequest) GetHoleCards() []string {
+	if x != nil {
+		return x.HoleCards
+	}
+	return nil
+}
+
+func (x *NutsRequest) GetMaxClasses() int32 {
+	if x != nil {
+		return x.MaxClasses
+	}
+	return 0
+}
+
+type NutsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NutClasses    []*NutClass `protobuf:"bytes,1,rep,name=nut_classes,json=nutClasses,proto3" json:"nut_classes,omitempty"`             // Best first, starting with the nuts
+	TotalClasses  int32       `protobuf:"varint,2,opt,name=total_classes,json=totalClasses,proto3" json:"total_classes,omitempty"`      // Distinct hands possible on the board
+	HeroClassRank int32       `protobuf:"varint,3,opt,name=hero_class_rank,json=heroClassRank,proto3" json:"hero_class_rank,omitempty"` // 1 when hero holds the nuts, 0 without hole cards
+	BeatsHero     []*Holding  `protobuf:"bytes,4,rep,name=beats_hero,json=beatsHero,proto3" json:"beats_hero,omitempty"`                // Opponent holdings that beat hero right now
+	TiesHero      []*Holding  `protobuf:"bytes,5,rep,name=ties_hero,json=tiesHero,proto3" json:"ties_hero,omitempty"`
+	LosesToHero   []*Holding  `protobuf:"bytes,6,rep,name=loses_to_hero,json=losesToHero,proto3" json:"loses_to_hero,omitempty"`
+}
+
+func (x *NutsResponse) Reset() {
+	*x = NutsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NutsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NutsResponse) ProtoMessage() {}
+
+func (x *NutsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NutsResponse.ProtoReflect.Descriptor instead.
+func (*NutsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *NutsResponse) GetNutClasses() []*NutClass {
+	if x != nil {
+		return x.NutClasses
+	}
+	return nil
+}
+
+func (x *NutsResponse) GetTotalClasses() int32 {
+	if x != nil {
+		return x.TotalClasses
+	}
+	return 0
+}
+
+func (x *NutsResponse) GetHeroClassRank() int32 {
+	if x != nil {
+		return x.HeroClassRank
+	}
+	return 0
+}
+
+func (x *NutsResponse) GetBeatsHero() []*Holding {
+	if x != nil {
+		return x.BeatsHero
+	}
+	return nil
+}
+
+func (x *NutsResponse) GetTiesHero() []*Holding {
+	if x != nil {
+		return x.TiesHero
+	}
+	return nil
+}
+
+func (x *NutsResponse) GetLosesToHero() []*Holding {
+	if x != nil {
+		return x.LosesToHero
+	}
+	return nil
+}
+
+type NutClass struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rank          int32      `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`                        // 1 for the nuts
+	HandName      string     `protobuf:"bytes,2,opt,name=hand_name,json=handName,proto3" json:"hand_name,omitempty"` // e.g. "Straight"
+	Description   string     `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`           // e.g. "Straight, J high"
+	HandRankValue int32      `protobuf:"varint,4,opt,name=hand_rank_value,json=handRankValue,proto3" json:"hand_rank_value,omitempty"`
+	Combos        int32      `protobuf:"varint,5,opt,name=combos,proto3" json:"combos,omitempty"`
+	Holdings      []*Holding `protobuf:"bytes,6,rep,name=holdings,proto3" json:"holdings,omitempty"`
+}
+
+func (x *NutClass) Reset() {
+	*x = NutClass{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NutClass) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NutClass) ProtoMessage() {}
+
+func (x *NutClass) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NutClass.ProtoReflect.Descriptor instead.
+func (*NutClass) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *NutClass) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *NutClass) GetHandName() string {
+	if x != nil {
+		return x.HandName
+	}
+	return ""
+}
+
+func (x *NutClass) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *NutClass) GetHandRankValue() int32 {
+	if x != nil {
+		return x.HandRankValue
+	}
+	return 0
+}
+
+func (x *NutClass) GetCombos() int32 {
+	if x != nil {
+		return x.Combos
+	}
+	return 0
+}
+
+func (x *NutClass) GetHoldings() []*Holding {
+	if x != nil {
+		return x.Holdings
+	}
+	return nil
+}
+
+type Holding struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cards []string `protobuf:"bytes,1,rep,name=cards,proto3" json:"cards,omitempty"` // e.g. ["HA", "HK"]
+}
+
+func (x *Holding) Reset() {
+	*x = Holding{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Holding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Holding) ProtoMessage() {}
+
+func (x *Holding) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Holding.ProtoReflect.Descriptor instead.
+func (*Holding) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Holding) GetCards() []string {
+	if x != nil {
+		return x.Cards
+	}
+	return nil
+}
+
+type BlockerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HoleCards      []string `protobuf:"bytes,1,rep,name=hole_cards,json=holeCards,proto3" json:"hole_cards,omitempty"`                // Hero's hole cards, e.g. ["SA", "D5"]
+	CommunityCards []string `protobuf:"bytes,2,rep,name=community_cards,json=communityCards,proto3" json:"community_cards,omitempty"` // None (preflop) or 3 to 5 cards
+	OpponentRange  string   `protobuf:"bytes,3,opt,name=opponent_range,json=opponentRange,proto3" json:"opponent_range,omitempty"`    // e.g. "22+,A2s+,KQs"
+}
+
+func (x *BlockerRequest) Reset() {
+	*x = BlockerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockerRequest) ProtoMessage() {}
+
+func (x *BlockerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockerRequest.ProtoReflect.Descriptor instead.
+func (*BlockerRequest) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *BlockerRequest) GetHoleCards() []string {
+	if x != nil {
+		return x.HoleCards
+	}
+	return nil
+}
+
+func (x *BlockerRequest) GetCommunityCards() []string {
+	if x != nil {
+		return x.CommunityCards
+	}
+	return nil
+}
+
+func (x *BlockerRequest) GetOpponentRange() string {
+	if x != nil {
+		return x.OpponentRange
+	}
+	return ""
+}
+
+type BlockerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groups            []*BlockerGroup `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`                                                    // Best hand category first
+	TotalCombosBefore float64         `protobuf:"fixed64,2,opt,name=total_combos_before,json=totalCombosBefore,proto3" json:"total_combos_before,omitempty"` // Range combos possible given the board, weighted
+	TotalCombosAfter  float64         `protobuf:"fixed64,3,opt,name=total_combos_after,json=totalCombosAfter,proto3" json:"total_combos_after,omitempty"`    // Range combos left once hero's cards are removed
+}
+
+func (x *BlockerResponse) Reset() {
+	*x = BlockerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockerResponse) ProtoMessage() {}
+
+func (x *BlockerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockerResponse.ProtoReflect.Descriptor instead.
+func (*BlockerResponse) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *BlockerResponse) GetGroups() []*BlockerGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *BlockerResponse) GetTotalCombosBefore() float64 {
+	if x != nil {
+		return x.TotalCombosBefore
+	}
+	return 0
+}
+
+func (x *BlockerResponse) GetTotalCombosAfter() float64 {
+	if x != nil {
+		return x.TotalCombosAfter
+	}
+	return 0
+}
+
+type BlockerGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HandName        string  `protobuf:"bytes,1,opt,name=hand_name,json=handName,proto3" json:"hand_name,omitempty"`               // HandRank category, e.g. "Flush"
+	CombosBefore    float64 `protobuf:"fixed64,2,opt,name=combos_before,json=combosBefore,proto3" json:"combos_before,omitempty"` // Weighted combos of the category
+	CombosAfter     float64 `protobuf:"fixed64,3,opt,name=combos_after,json=combosAfter,proto3" json:"combos_after,omitempty"`
+	BlockedFraction float64 `protobuf:"fixed64,4,opt,name=blocked_fraction,json=blockedFraction,proto3" json:"blocked_fraction,omitempty"` // Share of the category's combos hero blocks
+	NutDescription  string  `protobuf:"bytes,5,opt,name=nut_description,json=nutDescription,proto3" json:"nut_description,omitempty"`      // Best hand of the category in the range, e.g. "Flush, A-K-9-7-3"
+	NutCombosBefore float64 `protobuf:"fixed64,6,opt,name=nut_combos_before,json=nutCombosBefore,proto3" json:"nut_combos_before,omitempty"`
+	NutCombosAfter  float64 `protobuf:"fixed64,7,opt,name=nut_combos_after,json=nutCombosAfter,proto3" json:"nut_combos_after,omitempty"`
+}
+
+func (x *BlockerGroup) Reset() {
+	*x = BlockerGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockerGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockerGroup) ProtoMessage() {}
+
+func (x *BlockerGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockerGroup.ProtoReflect.Descriptor instead.
+func (*BlockerGroup) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *BlockerGroup) GetHandName() string {
+	if x != nil {
+		return x.HandName
+	}
+	return ""
+}
+
+func (x *BlockerGroup) GetCombosBefore() float64 {
+	if x != nil {
+		return x.CombosBefore
+	}
+	return 0
+}
+
+func (x *BlockerGroup) GetCombosAfter() float64 {
+	if x != nil {
+		return x.CombosAfter
+	}
+	return 0
+}
+
+func (x *BlockerGroup) GetBlockedFraction() float64 {
+	if x != nil {
+		return x.BlockedFraction
+	}
+	return 0
+}
+
+func (x *BlockerGroup) GetNutDescription() string {
+	if x != nil {
+		return x.NutDescription
+	}
+	return ""
+}
+
+func (x *BlockerGroup) GetNutCombosBefore() float64 {
+	if x != nil {
+		return x.NutCombosBefore
+	}
+	return 0
+}
+
+func (x *BlockerGroup) GetNutCombosAfter() float64 {
+	if x != nil {
+		return x.NutCombosAfter
+	}
+	return 0
+}
+
+type RangeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Range     string   `protobuf:"bytes,1,opt,name=range,proto3" json:"range,omitempty"`                          // e.g. "TT+,AQs+,AKo:0.5" or "15%"
+	DeadCards []string `protobuf:"bytes,2,rep,name=dead_cards,json=deadCards,proto3" json:"dead_cards,omitempty"` // Cards removed from the range, e.g. the board
+}
+
+func (x *RangeRequest) Reset() {
+	*x = RangeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RangeRequest) ProtoMessage() {}
+
+func (x *RangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RangeRequest.ProtoReflect.Descriptor instead.
+func (*RangeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RangeRequest) GetRange() string {
+	if x != nil {
+		return x.Range
+	}
+	return ""
+}
+
+func (x *RangeRequest) GetDeadCards() []string {
+	if x != nil {
+		return x.DeadCards
+	}
+	return nil
+}
+
+type RangeOperationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Left      string         `protobuf:"bytes,1,opt,name=left,proto3" json:"left,omitempty"`
+	Right     string         `protobuf:"bytes,2,opt,name=right,proto3" json:"right,omitempty"`
+	Operation RangeOperation `protobuf:"varint,3,opt,name=operation,proto3,enum=poker.RangeOperation" json:"operation,omitempty"`
+	Weight    float64        `protobuf:"fixed64,4,opt,name=weight,proto3" json:"weight,omitempty"` // Scale factor for RANGE_WEIGHT
+	DeadCards []string       `protobuf:"bytes,5,rep,name=dead_cards,json=deadCards,proto3" json:"dead_cards,omitempty"`
+}
+
+func (x *RangeOperationRequest) Reset() {
+	*x = RangeOperationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RangeOperationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RangeOperationRequest) ProtoMessage() {}
+
+func (x *RangeOperationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RangeOperationRequest.ProtoReflect.Descriptor instead.
+func (*RangeOperationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RangeOperationRequest) GetLeft() string {
+	if x != nil {
+		return x.Left
+	}
+	return ""
+}
+
+func (x *RangeOperationRequest) GetRight() string {
+	if x != nil {
+		return x.Right
+	}
+	return ""
+}
+
+func (x *RangeOperationRequest) GetOperation() RangeOperation {
+	if x != nil {
+		return x.Operation
+	}
+	return RangeOperation_RANGE_UNION
+}
+
+func (x *RangeOperationRequest) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *RangeOperationRequest) GetDeadCards() []string {
+	if x != nil {
+		return x.DeadCards
+	}
+	return nil
+}
+
+type RangeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Notation string          `protobuf:"bytes,1,opt,name=notation,proto3" json:"notation,omitempty"` // Compact notation, e.g. "TT+,AQs+"
+	Combos   float64         `protobuf:"fixed64,2,opt,name=combos,proto3" json:"combos,omitempty"`   // Weighted number of combos
+	Percent  float64         `protobuf:"fixed64,3,opt,name=percent,proto3" json:"percent,omitempty"` // Share of all 1326 combos
+	Grid     []*RangeGridRow `protobuf:"bytes,4,rep,name=grid,proto3" json:"grid,omitempty"`         // 13 rows from aces down
+}
+
+func (x *RangeResponse) Reset() {
+	*x = RangeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RangeResponse) ProtoMessage() {}
+
+func (x *RangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RangeResponse.ProtoReflect.Descriptor instead.
+func (*RangeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RangeResponse) GetNotation() string {
+	if x != nil {
+		return x.Notation
+	}
+	return ""
+}
+
+func (x *RangeResponse) GetCombos() float64 {
+	if x != nil {
+		return x.Combos
+	}
+	return 0
+}
+
+func (x *RangeResponse) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *RangeResponse) GetGrid() []*RangeGridRow {
+	if x != nil {
+		return x.Grid
+	}
+	return nil
+}
+
+type RangeGridRow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cells []*RangeGridCell `protobuf:"bytes,1,rep,name=cells,proto3" json:"cells,omitempty"` // 13 cells; suited above the diagonal, offsuit below
+}
+
+func (x *RangeGridRow) Reset() {
+	*x = RangeGridRow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RangeGridRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RangeGridRow) ProtoMessage() {}
+
+func (x *RangeGridRow) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RangeGridRow.ProtoReflect.Descriptor instead.
+func (*RangeGridRow) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RangeGridRow) GetCells() []*RangeGridCell {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+type RangeGridCell struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Label       string  `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`                                 // e.g. "AKs"
+	Combos      float64 `protobuf:"fixed64,2,opt,name=combos,proto3" json:"combos,omitempty"`                             // Weighted combos of the class in the range
+	TotalCombos int32   `protobuf:"varint,3,opt,name=total_combos,json=totalCombos,proto3" json:"total_combos,omitempty"` // 6 for pairs, 4 suited, 12 offsuit
+	Frequency   float64 `protobuf:"fixed64,4,opt,name=frequency,proto3" json:"frequency,omitempty"`                       // combos / total_combos
+}
+
+func (x *RangeGridCell) Reset() {
+	*x = RangeGridCell{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RangeGridCell) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RangeGridCell) ProtoMessage() {}
+
+func (x *RangeGridCell) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RangeGridCell.ProtoReflect.Descriptor instead.
+func (*RangeGridCell) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RangeGridCell) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *RangeGridCell) GetCombos() float64 {
+	if x != nil {
+		return x.Combos
+	}
+	return 0
+}
+
+func (x *RangeGridCell) GetTotalCombos() int32 {
+	if x != nil {
+		return x.TotalCombos
+	}
+	return 0
+}
+
+func (x *RangeGridCell) GetFrequency() float64 {
+	if x != nil {
+		return x.Frequency
+	}
+	return 0
+}
+
+type HeatmapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommunityCards []string `protobuf:"bytes,1,rep,name=community_cards,json=communityCards,proto3" json:"community_cards,omitempty"`  // None (preflop) or 3 to 5 cards
+	OpponentRange  string   `protobuf:"bytes,2,opt,name=opponent_range,json=opponentRange,proto3" json:"opponent_range,omitempty"`     // e.g. "22+,A2s+"; every hand when empty
+	NumSimulations int32    `protobuf:"varint,3,opt,name=num_simulations,json=numSimulations,proto3" json:"num_simulations,omitempty"` // Deals per starting hand class, ignored on the river
+}
+
+func (x *HeatmapRequest) Reset() {
+	*x = HeatmapRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeatmapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeatmapRequest) ProtoMessage() {}
+
+func (x *HeatmapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeatmapRequest.ProtoReflect.Descriptor instead.
+func (*HeatmapRequest) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *HeatmapRequest) GetCommunityCards() []string {
+	if x != nil {
+		return x.CommunityCards
+	}
+	return nil
+}
+
+func (x *HeatmapRequest) GetOpponentRange() string {
+	if x != nil {
+		return x.OpponentRange
+	}
+	return ""
+}
+
+func (x *HeatmapRequest) GetNumSimulations() int32 {
+	if x != nil {
+		return x.NumSimulations
+	}
+	return 0
+}
+
+type HeatmapResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rows []*HeatmapRow `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"` // 13 rows from aces down, laid out like RangeGridRow
+}
+
+func (x *HeatmapResponse) Reset() {
+	*x = HeatmapResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeatmapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeatmapResponse) ProtoMessage() {}
+
+func (x *HeatmapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeatmapResponse.ProtoReflect.Descriptor instead.
+func (*HeatmapResponse) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *HeatmapResponse) GetRows() []*HeatmapRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+type HeatmapRow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cells []*HeatmapCell `protobuf:"bytes,1,rep,name=cells,proto3" json:"cells,omitempty"`
+}
+
+func (x *HeatmapRow) Reset() {
+	*x = HeatmapRow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeatmapRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeatmapRow) ProtoMessage() {}
+
+func (x *HeatmapRow) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeatmapRow.ProtoReflect.Descriptor instead.
+func (*HeatmapRow) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *HeatmapRow) GetCells() []*HeatmapCell {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+type HeatmapCell struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Label  string  `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`    // e.g. "AKs"
+	Combos int32   `protobuf:"varint,2,opt,name=combos,proto3" json:"combos,omitempty"` // Hero combos of the class the board leaves, 0 if none
+	Win    float64 `protobuf:"fixed64,3,opt,name=win,proto3" json:"win,omitempty"`
+	Tie    float64 `protobuf:"fixed64,4,opt,name=tie,proto3" json:"tie,omitempty"`
+	Equity float64 `protobuf:"fixed64,5,opt,name=equity,proto3" json:"equity,omitempty"` // win + tie / 2
+}
+
+func (x *HeatmapCell) Reset() {
+	*x = HeatmapCell{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeatmapCell) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeatmapCell) ProtoMessage() {}
+
+func (x *HeatmapCell) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeatmapCell.ProtoReflect.Descriptor instead.
+func (*HeatmapCell) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *HeatmapCell) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *HeatmapCell) GetCombos() int32 {
+	if x != nil {
+		return x.Combos
+	}
+	return 0
+}
+
+func (x *HeatmapCell) GetWin() float64 {
+	if x != nil {
+		return x.Win
+	}
+	return 0
+}
+
+func (x *HeatmapCell) GetTie() float64 {
+	if x != nil {
+		return x.Tie
+	}
+	return 0
+}
+
+func (x *HeatmapCell) GetEquity() float64 {
+	if x != nil {
+		return x.Equity
+	}
+	return 0
+}
+
+type TimelineRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Players        []*Holding `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`                                     // Each player's 2 hole cards
+	CommunityCards []string   `protobuf:"bytes,2,rep,name=community_cards,json=communityCards,proto3" json:"community_cards,omitempty"` // Board dealt so far: none, or 3 to 5 cards
+}
+
+func (x *TimelineRequest) Reset() {
+	*x = TimelineRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimelineRequest) ProtoMessage() {}
+
+func (x *TimelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimelineRequest.ProtoReflect.Descriptor instead.
+func (*TimelineRequest) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *TimelineRequest) GetPlayers() []*Holding {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *TimelineRequest) GetCommunityCards() []string {
+	if x != nil {
+		return x.CommunityCards
+	}
+	return nil
+}
+
+type TimelineResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Streets []*StreetEquity `protobuf:"bytes,1,rep,name=streets,proto3" json:"streets,omitempty"` // Preflop first, up to the last known street
+}
+
+func (x *TimelineResponse) Reset() {
+	*x = TimelineResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimelineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimelineResponse) ProtoMessage() {}
+
+func (x *TimelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimelineResponse.ProtoReflect.Descriptor instead.
+func (*TimelineResponse) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *TimelineResponse) GetStreets() []*StreetEquity {
+	if x != nil {
+		return x.Streets
+	}
+	return nil
+}
+
+type StreetEquity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Street      string    `protobuf:"bytes,1,opt,name=street,proto3" json:"street,omitempty"` // "Preflop", "Flop", "Turn" or "River"
+	Board       []string  `protobuf:"bytes,2,rep,name=board,proto3" json:"board,omitempty"`
+	NewCards    []string  `protobuf:"bytes,3,rep,name=new_cards,json=newCards,proto3" json:"new_cards,omitempty"`           // Cards dealt on this street
+	Equities    []float64 `protobuf:"fixed64,4,rep,packed,name=equities,proto3" json:"equities,omitempty"`                  // One per player, ties split
+	Leader      int32     `protobuf:"varint,5,opt,name=leader,proto3" json:"leader,omitempty"`                              // 1-based player with the most equity, 0 when tied
+	LeadChanged bool      `protobuf:"varint,6,opt,name=lead_changed,json=leadChanged,proto3" json:"lead_changed,omitempty"` // The new cards changed the leader
+}
+
+func (x *StreetEquity) Reset() {
+	*x = StreetEquity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreetEquity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreetEquity) ProtoMessage() {}
+
+func (x *StreetEquity) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreetEquity.ProtoReflect.Descriptor instead.
+func (*StreetEquity) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *StreetEquity) GetStreet() string {
+	if x != nil {
+		return x.Street
+	}
+	return ""
+}
+
+func (x *StreetEquity) GetBoard() []string {
+	if x != nil {
+		return x.Board
+	}
+	return nil
+}
+
+func (x *StreetEquity) GetNewCards() []string {
+	if x != nil {
+		return x.NewCards
+	}
+	return nil
+}
+
+func (x *StreetEquity) GetEquities() []float64 {
+	if x != nil {
+		return x.Equities
+	}
+	return nil
+}
+
+func (x *StreetEquity) GetLeader() int32 {
+	if x != nil {
+		return x.Leader
+	}
+	return 0
+}
+
+func (x *StreetEquity) GetLeadChanged() bool {
+	if x != nil {
+		return x.LeadChanged
+	}
+	return false
+}
+
+type FlopAnalysisRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HeroRange      string `protobuf:"bytes,1,opt,name=hero_range,json=heroRange,proto3" json:"hero_range,omitempty"` // e.g. "22+,A2s+,KTs+"
+	VillainRange   string `protobuf:"bytes,2,opt,name=villain_range,json=villainRange,proto3" json:"villain_range,omitempty"`
+	SubsetSize     int32  `protobuf:"varint,3,opt,name=subset_size,json=subsetSize,proto3" json:"subset_size,omitempty"`             // Representative flops to analyze; all 1755 when 0, before any suit expansion
+	NumSimulations int32  `protobuf:"varint,4,opt,name=num_simulations,json=numSimulations,proto3" json:"num_simulations,omitempty"` // Turn and river runouts sampled per flop
+}
+
+func (x *FlopAnalysisRequest) Reset() {
+	*x = FlopAnalysisRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlopAnalysisRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlopAnalysisRequest) ProtoMessage() {}
+
+func (x *FlopAnalysisRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlopAnalysisRequest.ProtoReflect.Descriptor instead.
+func (*FlopAnalysisRequest) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *FlopAnalysisRequest) GetHeroRange() string {
+	if x != nil {
+		return x.HeroRange
+	}
+	return ""
+}
+
+func (x *FlopAnalysisRequest) GetVillainRange() string {
+	if x != nil {
+		return x.VillainRange
+	}
+	return ""
+}
+
+func (x *FlopAnalysisRequest) GetSubsetSize() int32 {
+	if x != nil {
+		return x.SubsetSize
+	}
+	return 0
+}
+
+func (x *FlopAnalysisRequest) GetNumSimulations() int32 {
+	if x != nil {
+		return x.NumSimulations
+	}
+	return 0
+}
+
+// One update per finished flop, then a final update with the aggregates
+type FlopAnalysisUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flop       *FlopResult       `protobuf:"bytes,1,opt,name=flop,proto3" json:"flop,omitempty"` // Set on per-flop updates
+	FlopsDone  int32             `protobuf:"varint,2,opt,name=flops_done,json=flopsDone,proto3" json:"flops_done,omitempty"`
+	FlopsTotal int32             `protobuf:"varint,3,opt,name=flops_total,json=flopsTotal,proto3" json:"flops_total,omitempty"`
+	Textures   []*TextureSummary `protobuf:"bytes,4,rep,name=textures,proto3" json:"textures,omitempty"` // Set on the final update, most frequent first
+	Overall    *TextureSummary   `protobuf:"bytes,5,opt,name=overall,proto3" json:"overall,omitempty"`   // Set on the final update
+}
+
+func (x *FlopAnalysisUpdate) Reset() {
+	*x = FlopAnalysisUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlopAnalysisUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlopAnalysisUpdate) ProtoMessage() {}
+
+func (x *FlopAnalysisUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlopAnalysisUpdate.ProtoReflect.Descriptor instead.
+func (*FlopAnalysisUpdate) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *FlopAnalysisUpdate) GetFlop() *FlopResult {
+	if x != nil {
+		return x.Flop
+	}
+	return nil
+}
+
+func (x *FlopAnalysisUpdate) GetFlopsDone() int32 {
+	if x != nil {
+		return x.FlopsDone
+	}
+	return 0
+}
+
+func (x *FlopAnalysisUpdate) GetFlopsTotal() int32 {
+	if x != nil {
+		return x.FlopsTotal
+	}
+	return 0
+}
+
+func (x *FlopAnalysisUpdate) GetTextures() []*TextureSummary {
+	if x != nil {
+		return x.Textures
+	}
+	return nil
+}
+
+func (x *FlopAnalysisUpdate) GetOverall() *TextureSummary {
+	if x != nil {
+		return x.Overall
+	}
+	return nil
+}
+
+type FlopResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cards   []string     `protobuf:"bytes,1,rep,name=cards,proto3" json:"cards,omitempty"`
+	Weight  float64      `protobuf:"fixed64,2,opt,name=weight,proto3" json:"weight,omitempty"` // Flops of the full deck this flop stands for
+	Texture string       `protobuf:"bytes,3,opt,name=texture,proto3" json:"texture,omitempty"` // e.g. "Two-Tone, Unpaired, Connected"
+	Equity  float64      `protobuf:"fixed64,4,opt,name=equity,proto3" json:"equity,omitempty"` // Hero range equity
+	Hands   []*HandShare `protobuf:"bytes,5,rep,name=hands,proto3" json:"hands,omitempty"`
+}
+
+func (x *FlopResult) Reset() {
+	*x = FlopResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlopResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlopResult) ProtoMessage() {}
+
+func (x *FlopResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlopResult.ProtoReflect.Descriptor instead.
+func (*FlopResult) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *FlopResult) GetCards() []string {
+	if x != nil {
+		return x.Cards
+	}
+	return nil
+}
+
+func (x *FlopResult) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *FlopResult) GetTexture() string {
+	if x != nil {
+		return x.Texture
+	}
+	return ""
+}
+
+func (x *FlopResult) GetEquity() float64 {
+	if x != nil {
+		return x.Equity
+	}
+	return 0
+}
+
+func (x *FlopResult) GetHands() []*HandShare {
+	if x != nil {
+		return x.Hands
+	}
+	return nil
+}
+
+type TextureSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Texture string       `protobuf:"bytes,1,opt,name=texture,proto3" json:"texture,omitempty"`
+	Flops   int32        `protobuf:"varint,2,opt,name=flops,proto3" json:"flops,omitempty"`
+	Weight  float64      `protobuf:"fixed64,3,opt,name=weight,proto3" json:"weight,omitempty"`
+	Equity  float64      `protobuf:"fixed64,4,opt,name=equity,proto3" json:"equity,omitempty"` // Weighted by flop frequency
+	Hands   []*HandShare `protobuf:"bytes,5,rep,name=hands,proto3" json:"hands,omitempty"`
+}
+
+func (x *TextureSummary) Reset() {
+	*x = TextureSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TextureSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TextureSummary) ProtoMessage() {}
+
+func (x *TextureSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TextureSummary.ProtoReflect.Descriptor instead.
+func (*TextureSummary) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *TextureSummary) GetTexture() string {
+	if x != nil {
+		return x.Texture
+	}
+	return ""
+}
+
+func (x *TextureSummary) GetFlops() int32 {
+	if x != nil {
+		return x.Flops
+	}
+	return 0
+}
+
+func (x *TextureSummary) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *TextureSummary) GetEquity() float64 {
+	if x != nil {
+		return x.Equity
+	}
+	return 0
+}
+
+func (x *TextureSummary) GetHands() []*HandShare {
+	if x != nil {
+		return x.Hands
+	}
+	return nil
+}
+
+type HandShare struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HandName string  `protobuf:"bytes,1,opt,name=hand_name,json=handName,proto3" json:"hand_name,omitempty"` // HandRank category, e.g. "Two Pair"
+	Hero     float64 `protobuf:"fixed64,2,opt,name=hero,proto3" json:"hero,omitempty"`                       // Share of hero's range making the hand on the flop
+	Villain  float64 `protobuf:"fixed64,3,opt,name=villain,proto3" json:"villain,omitempty"`
+}
+
+func (x *HandShare) Reset() {
+	*x = HandShare{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HandShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandShare) ProtoMessage() {}
+
+func (x *HandShare) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandShare.ProtoReflect.Descriptor instead.
+func (*HandShare) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *HandShare) GetHandName() string {
+	if x != nil {
+		return x.HandName
+	}
+	return ""
+}
+
+func (x *HandShare) GetHero() float64 {
+	if x != nil {
+		return x.Hero
+	}
+	return 0
+}
+
+func (x *HandShare) GetVillain() float64 {
+	if x != nil {
+		return x.Villain
+	}
+	return 0
+}
+
+type QueryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Query          string      `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"` // e.g. "P(board pairs on turn | flop=Ks7d2c)"
+	Method         QueryMethod `protobuf:"varint,2,opt,name=method,proto3,enum=poker.QueryMethod" json:"method,omitempty"`
+	NumSimulations int32       `protobuf:"varint,3,opt,name=num_simulations,json=numSimulations,proto3" json:"num_simulations,omitempty"` // Deals sampled by Monte Carlo
+}
+
+func (x *QueryRequest) Reset() {
+	*x = QueryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryRequest) ProtoMessage() {}
+
+func (x *QueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryRequest.ProtoReflect.Descriptor instead.
+func (*QueryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *QueryRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *QueryRequest) GetMethod() QueryMethod {
+	if x != nil {
+		return x.Method
+	}
+	return QueryMethod_QUERY_AUTO
+}
+
+func (x *QueryRequest) GetNumSimulations() int32 {
+	if x != nil {
+		return x.NumSimulations
+	}
+	return 0
+}
+
+type QueryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Probability   float64     `protobuf:"fixed64,1,opt,name=probability,proto3" json:"probability,omitempty"`
+	Hits          float64     `protobuf:"fixed64,2,opt,name=hits,proto3" json:"hits,omitempty"`   // Deals where the event held
+	Deals         float64     `protobuf:"fixed64,3,opt,name=deals,proto3" json:"deals,omitempty"` // Deals where the conditions held
+	MethodUsed    QueryMethod `protobuf:"varint,4,opt,name=method_used,json=methodUsed,proto3,enum=poker.QueryMethod" json:"method_used,omitempty"`
+	StandardError float64     `protobuf:"fixed64,5,opt,name=standard_error,json=standardError,proto3" json:"standard_error,omitempty"` // 0 when exact
+}
+
+func (x *QueryResponse) Reset() {
+	*x = QueryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryResponse) ProtoMessage() {}
+
+func (x *QueryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryResponse.ProtoReflect.Descriptor instead.
+func (*QueryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *QueryResponse) GetProbability() float64 {
+	if x != nil {
+		return x.Probability
+	}
+	return 0
+}
+
+func (x *QueryResponse) GetHits() float64 {
+	if x != nil {
+		return x.Hits
+	}
+	return 0
+}
+
+func (x *QueryResponse) GetDeals() float64 {
+	if x != nil {
+		return x.Deals
+	}
+	return 0
+}
+
+func (x *QueryResponse) GetMethodUsed() QueryMethod {
+	if x != nil {
+		return x.MethodUsed
+	}
+	return QueryMethod_QUERY_AUTO
+}
+
+func (x *QueryResponse) GetStandardError() float64 {
+	if x != nil {
+		return x.StandardError
+	}
+	return 0
+}
+
+type ShowdownRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommunityCards []string        `protobuf:"bytes,1,rep,name=community_cards,json=communityCards,proto3" json:"community_cards,omitempty"` // Shared board, 3 to 5 cards
+	Players        []*ShowdownSeat `protobuf:"bytes,2,rep,name=players,proto3" json:"players,omitempty"`
+	Pot            int64           `protobuf:"varint,3,opt,name=pot,proto3" json:"pot,omitempty"`       // Chips to split between the winners
+	Button         int32           `protobuf:"varint,4,opt,name=button,proto3" json:"button,omitempty"` // Odd chips go to winners left of this seat first
+}
+
+func (x *ShowdownRequest) Reset() {
+	*x = ShowdownRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShowdownRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShowdownRequest) ProtoMessage() {}
+
+func (x *ShowdownRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShowdownRequest.ProtoReflect.Descriptor instead.
+func (*ShowdownRequest) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ShowdownRequest) GetCommunityCards() []string {
+	if x != nil {
+		return x.CommunityCards
+	}
+	return nil
+}
+
+func (x *ShowdownRequest) GetPlayers() []*ShowdownSeat {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *ShowdownRequest) GetPot() int64 {
+	if x != nil {
+		return x.Pot
+	}
+	return 0
+}
+
+func (x *ShowdownRequest) GetButton() int32 {
+	if x != nil {
+		return x.Button
+	}
+	return 0
+}
+
+type ShowdownSeat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seat      int32    `protobuf:"varint,1,opt,name=seat,proto3" json:"seat,omitempty"`
+	HoleCards []string `protobuf:"bytes,2,rep,name=hole_cards,json=holeCards,proto3" json:"hole_cards,omitempty"`
+}
+
+func (x *ShowdownSeat) Reset() {
+	*x = ShowdownSeat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShowdownSeat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShowdownSeat) ProtoMessage() {}
+
+func (x *ShowdownSeat) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShowdownSeat.ProtoReflect.Descriptor instead.
+func (*ShowdownSeat) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ShowdownSeat) GetSeat() int32 {
+	if x != nil {
+		return x.Seat
+	}
+	return 0
+}
+
+func (x *ShowdownSeat) GetHoleCards() []string {
+	if x != nil {
+		return x.HoleCards
+	}
+	return nil
+}
+
+type ShowdownResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*ShowdownResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`         // Best hand first
+	Places  []*TieGroup       `protobuf:"bytes,2,rep,name=places,proto3" json:"places,omitempty"`           // Seats grouped by place, best first
+	Winners []int32           `protobuf:"varint,3,rep,packed,name=winners,proto3" json:"winners,omitempty"` // Seats sharing the pot, in odd chip order
+}
+
+func (x *ShowdownResponse) Reset() {
+	*x = ShowdownResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShowdownResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShowdownResponse) ProtoMessage() {}
+
+func (x *ShowdownResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShowdownResponse.ProtoReflect.Descriptor instead.
+func (*ShowdownResponse) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ShowdownResponse) GetResults() []*ShowdownResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *ShowdownResponse) GetPlaces() []*TieGroup {
+	if x != nil {
+		return x.Places
+	}
+	return nil
+}
+
+func (x *ShowdownResponse) GetWinners() []int32 {
+	if x != nil {
+		return x.Winners
+	}
+	return nil
+}
+
+type ShowdownResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seat        int32         `protobuf:"varint,1,opt,name=seat,proto3" json:"seat,omitempty"`
+	Hand        *HandResponse `protobuf:"bytes,2,opt,name=hand,proto3" json:"hand,omitempty"`
+	Description string        `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"` // e.g. "Full House, Ks full of 7s"
+	Place       int32         `protobuf:"varint,4,opt,name=place,proto3" json:"place,omitempty"`            // 1 for the winners; tied players share a place
+	Payout      int64         `protobuf:"varint,5,opt,name=payout,proto3" json:"payout,omitempty"`
+	OddChips    int64         `protobuf:"varint,6,opt,name=odd_chips,json=oddChips,proto3" json:"odd_chips,omitempty"` // Part of the payout beyond an even split
+}
+
+func (x *ShowdownResult) Reset() {
+	*x = ShowdownResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShowdownResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShowdownResult) ProtoMessage() {}
+
+func (x *ShowdownResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShowdownResult.ProtoReflect.Descriptor instead.
+func (*ShowdownResult) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ShowdownResult) GetSeat() int32 {
+	if x != nil {
+		return x.Seat
+	}
+	return 0
+}
+
+func (x *ShowdownResult) GetHand() *HandResponse {
+	if x != nil {
+		return x.Hand
+	}
+	return nil
+}
+
+func (x *ShowdownResult) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ShowdownResult) GetPlace() int32 {
+	if x != nil {
+		return x.Place
+	}
+	return 0
+}
+
+func (x *ShowdownResult) GetPayout() int64 {
+	if x != nil {
+		return x.Payout
+	}
+	return 0
+}
+
+func (x *ShowdownResult) GetOddChips() int64 {
+	if x != nil {
+		return x.OddChips
+	}
+	return 0
+}
+
+type TieGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seats []int32 `protobuf:"varint,1,rep,packed,name=seats,proto3" json:"seats,omitempty"`
+}
+
+func (x *TieGroup) Reset() {
+	*x = TieGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TieGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TieGroup) ProtoMessage() {}
+
+func (x *TieGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TieGroup.ProtoReflect.Descriptor instead.
+func (*TieGroup) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *TieGroup) GetSeats() []int32 {
+	if x != nil {
+		return x.Seats
+	}
+	return nil
+}
+
+type BatchItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Item:
+	//	*BatchItem_Evaluate
+	//	*BatchItem_Compare
+	Item isBatchItem_Item `protobuf_oneof:"item"`
+}
+
+func (x *BatchItem) Reset() {
+	*x = BatchItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchItem) ProtoMessage() {}
+
+func (x *BatchItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchItem.ProtoReflect.Descriptor instead.
+func (*BatchItem) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{46}
+}
+
+func (m *BatchItem) GetItem() isBatchItem_Item {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+func (x *BatchItem) GetEvaluate() *HandRequest {
+	if x, ok := x.GetItem().(*BatchItem_Evaluate); ok {
+		return x.Evaluate
+	}
+	return nil
+}
+
+func (x *BatchItem) GetCompare() *CompareRequest {
+	if x, ok := x.GetItem().(*BatchItem_Compare); ok {
+		return x.Compare
+	}
+	return nil
+}
+
+type isBatchItem_Item interface {
+	isBatchItem_Item()
+}
+
+type BatchItem_Evaluate struct {
+	Evaluate *HandRequest `protobuf:"bytes,1,opt,name=evaluate,proto3,oneof"`
+}
+
+type BatchItem_Compare struct {
+	Compare *CompareRequest `protobuf:"bytes,2,opt,name=compare,proto3,oneof"`
+}
+
+func (*BatchItem_Evaluate) isBatchItem_Item() {}
+
+func (*BatchItem_Compare) isBatchItem_Item() {}
+
+type BatchResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index int64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"` // 0-based position of the item in the batch or stream
+	// Types that are assignable to Result:
+	//	*BatchResult_Evaluate
+	//	*BatchResult_Compare
+	Result isBatchResult_Result `protobuf_oneof:"result"`
+	Error  string               `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"` // Set instead of a result when the item failed
+}
+
+func (x *BatchResult) Reset() {
+	*x = BatchResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchResult) ProtoMessage() {}
+
+func (x *BatchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchResult.ProtoReflect.Descriptor instead.
+func (*BatchResult) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *BatchResult) GetIndex() int64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (m *BatchResult) GetResult() isBatchResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *BatchResult) GetEvaluate() *HandResponse {
+	if x, ok := x.GetResult().(*BatchResult_Evaluate); ok {
+		return x.Evaluate
+	}
+	return nil
+}
+
+func (x *BatchResult) GetCompare() *CompareResponse {
+	if x, ok := x.GetResult().(*BatchResult_Compare); ok {
+		return x.Compare
+	}
+	return nil
+}
+
+func (x *BatchResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type isBatchResult_Result interface {
+	isBatchResult_Result()
+}
+
+type BatchResult_Evaluate struct {
+	Evaluate *HandResponse `protobuf:"bytes,2,opt,name=evaluate,proto3,oneof"`
+}
+
+type BatchResult_Compare struct {
+	Compare *CompareResponse `protobuf:"bytes,3,opt,name=compare,proto3,oneof"`
+}
+
+func (*BatchResult_Evaluate) isBatchResult_Result() {}
+
+func (*BatchResult_Compare) isBatchResult_Result() {}
+
+type BatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items   []*BatchItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Workers int32        `protobuf:"varint,2,opt,name=workers,proto3" json:"workers,omitempty"` // Items processed at once; the number of CPUs when 0
+}
+
+func (x *BatchRequest) Reset() {
+	*x = BatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchRequest) ProtoMessage() {}
+
+func (x *BatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchRequest.ProtoReflect.Descriptor instead.
+func (*BatchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *BatchRequest) GetItems() []*BatchItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *BatchRequest) GetWorkers() int32 {
+	if x != nil {
+		return x.Workers
+	}
+	return 0
+}
+
+type BatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*BatchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // One per item, in item order
+	Failed  int32          `protobuf:"varint,2,opt,name=failed,proto3" json:"failed,omitempty"`  // Items that returned an error
+}
+
+func (x *BatchResponse) Reset() {
+	*x = BatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchResponse) ProtoMessage() {}
+
+func (x *BatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchResponse.ProtoReflect.Descriptor instead.
+func (*BatchResponse) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *BatchResponse) GetResults() []*BatchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BatchResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+type DoubleBoardShowdownRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TopBoardCards    []string        `protobuf:"bytes,1,rep,name=top_board_cards,json=topBoardCards,proto3" json:"top_board_cards,omitempty"`          // 3 to 5 cards
+	BottomBoardCards []string        `protobuf:"bytes,2,rep,name=bottom_board_cards,json=bottomBoardCards,proto3" json:"bottom_board_cards,omitempty"` // 3 to 5 cards, none shared with the top board
+	Players          []*ShowdownSeat `protobuf:"bytes,3,rep,name=players,proto3" json:"players,omitempty"`
+	Pot              int64           `protobuf:"varint,4,opt,name=pot,proto3" json:"pot,omitempty"`       // Half to each board; the top board plays for an odd chip
+	Button           int32           `protobuf:"varint,5,opt,name=button,proto3" json:"button,omitempty"` // Odd chips go to winners left of this seat first
+}
+
+func (x *DoubleBoardShowdownRequest) Reset() {
+	*x = DoubleBoardShowdownRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoubleBoardShowdownRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoubleBoardShowdownRequest) ProtoMessage() {}
+
+func (x *DoubleBoardShowdownRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoubleBoardShowdownRequest.ProtoReflect.Descriptor instead.
+func (*DoubleBoardShowdownRequest) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *DoubleBoardShowdownRequest) GetTopBoardCards() []string {
+	if x != nil {
+		return x.TopBoardCards
+	}
+	return nil
+}
+
+func (x *DoubleBoardShowdownRequest) GetBottomBoardCards() []string {
+	if x != nil {
+		return x.BottomBoardCards
+	}
+	return nil
+}
+
+func (x *DoubleBoardShowdownRequest) GetPlayers() []*ShowdownSeat {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *DoubleBoardShowdownRequest) GetPot() int64 {
+	if x != nil {
+		return x.Pot
+	}
+	return 0
+}
+
+func (x *DoubleBoardShowdownRequest) GetButton() int32 {
+	if x != nil {
+		return x.Button
+	}
+	return 0
+}
+
+type DoubleBoardShowdownResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TopBoard    *ShowdownResponse    `protobuf:"bytes,1,opt,name=top_board,json=topBoard,proto3" json:"top_board,omitempty"`
+	BottomBoard *ShowdownResponse    `protobuf:"bytes,2,opt,name=bottom_board,json=bottomBoard,proto3" json:"bottom_board,omitempty"`
+	Payouts     []*DoubleBoardPayout `protobuf:"bytes,3,rep,name=payouts,proto3" json:"payouts,omitempty"` // In request order
+}
+
+func (x *DoubleBoardShowdownResponse) Reset() {
+	*x = DoubleBoardShowdownResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoubleBoardShowdownResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoubleBoardShowdownResponse) ProtoMessage() {}
+
+func (x *DoubleBoardShowdownResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoubleBoardShowdownResponse.ProtoReflect.Descriptor instead.
+func (*DoubleBoardShowdownResponse) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *DoubleBoardShowdownResponse) GetTopBoard() *ShowdownResponse {
+	if x != nil {
+		return x.TopBoard
+	}
+	return nil
+}
+
+func (x *DoubleBoardShowdownResponse) GetBottomBoard() *ShowdownResponse {
+	if x != nil {
+		return x.BottomBoard
+	}
+	return nil
+}
+
+func (x *DoubleBoardShowdownResponse) GetPayouts() []*DoubleBoardPayout {
+	if x != nil {
+		return x.Payouts
+	}
+	return nil
+}
+
+type DoubleBoardPayout struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seat   int32 `protobuf:"varint,1,opt,name=seat,proto3" json:"seat,omitempty"`
+	Payout int64 `protobuf:"varint,2,opt,name=payout,proto3" json:"payout,omitempty"` // Chips won over both boards
+	Scoop  bool  `protobuf:"varint,3,opt,name=scoop,proto3" json:"scoop,omitempty"`   // Won both halves alone
+}
+
+func (x *DoubleBoardPayout) Reset() {
+	*x = DoubleBoardPayout{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoubleBoardPayout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoubleBoardPayout) ProtoMessage() {}
+
+func (x *DoubleBoardPayout) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoubleBoardPayout.ProtoReflect.Descriptor instead.
+func (*DoubleBoardPayout) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DoubleBoardPayout) GetSeat() int32 {
+	if x != nil {
+		return x.Seat
+	}
+	return 0
+}
+
+func (x *DoubleBoardPayout) GetPayout() int64 {
+	if x != nil {
+		return x.Payout
+	}
+	return 0
+}
+
+func (x *DoubleBoardPayout) GetScoop() bool {
+	if x != nil {
+		return x.Scoop
+	}
+	return false
+}
+
+type DoubleBoardEquityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TopBoardCards    []string        `protobuf:"bytes,1,rep,name=top_board_cards,json=topBoardCards,proto3" json:"top_board_cards,omitempty"`          // Known cards, 0 to 5
+	BottomBoardCards []string        `protobuf:"bytes,2,rep,name=bottom_board_cards,json=bottomBoardCards,proto3" json:"bottom_board_cards,omitempty"` // Known cards, 0 to 5
+	Players          []*ShowdownSeat `protobuf:"bytes,3,rep,name=players,proto3" json:"players,omitempty"`
+	DeadCards        []string        `protobuf:"bytes,4,rep,name=dead_cards,json=deadCards,proto3" json:"dead_cards,omitempty"` // Cards out of play, e.g. burned or folded
+	NumSimulations   int32           `protobuf:"varint,5,opt,name=num_simulations,json=numSimulations,proto3" json:"num_simulations,omitempty"`
+}
+
+func (x *DoubleBoardEquityRequest) Reset() {
+	*x = DoubleBoardEquityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoubleBoardEquityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoubleBoardEquityRequest) ProtoMessage() {}
+
+func (x *DoubleBoardEquityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoubleBoardEquityRequest.ProtoReflect.Descriptor instead.
+func (*DoubleBoardEquityRequest) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *DoubleBoardEquityRequest) GetTopBoardCards() []string {
+	if x != nil {
+		return x.TopBoardCards
+	}
+	return nil
+}
+
+func (x *DoubleBoardEquityRequest) GetBottomBoardCards() []string {
+	if x != nil {
+		return x.BottomBoardCards
+	}
+	return nil
+}
+
+func (x *DoubleBoardEquityRequest) GetPlayers() []*ShowdownSeat {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *DoubleBoardEquityRequest) GetDeadCards() []string {
+	if x != nil {
+		return x.DeadCards
+	}
+	return nil
+}
+
+func (x *DoubleBoardEquityRequest) GetNumSimulations() int32 {
+	if x != nil {
+		return x.NumSimulations
+	}
+	return 0
+}
+
+type DoubleBoardEquityResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Equities       []*DoubleBoardEquity `protobuf:"bytes,1,rep,name=equities,proto3" json:"equities,omitempty"` // In request order
+	SimulationsRun int32                `protobuf:"varint,2,opt,name=simulations_run,json=simulationsRun,proto3" json:"simulations_run,omitempty"`
+}
+
+func (x *DoubleBoardEquityResponse) Reset() {
+	*x = DoubleBoardEquityResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoubleBoardEquityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoubleBoardEquityResponse) ProtoMessage() {}
+
+func (x *DoubleBoardEquityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoubleBoardEquityResponse.ProtoReflect.Descriptor instead.
+func (*DoubleBoardEquityResponse) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *DoubleBoardEquityResponse) GetEquities() []*DoubleBoardEquity {
+	if x != nil {
+		return x.Equities
+	}
+	return nil
+}
+
+func (x *DoubleBoardEquityResponse) GetSimulationsRun() int32 {
+	if x != nil {
+		return x.SimulationsRun
+	}
+	return 0
+}
+
+type DoubleBoardEquity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seat              int32   `protobuf:"varint,1,opt,name=seat,proto3" json:"seat,omitempty"`
+	Equity            float64 `protobuf:"fixed64,2,opt,name=equity,proto3" json:"equity,omitempty"`                                         // Share of the whole pot won on average
+	TopBoardEquity    float64 `protobuf:"fixed64,3,opt,name=top_board_equity,json=topBoardEquity,proto3" json:"top_board_equity,omitempty"` // Share of the top board's half won on average
+	BottomBoardEquity float64 `protobuf:"fixed64,4,opt,name=bottom_board_equity,json=bottomBoardEquity,proto3" json:"bottom_board_equity,omitempty"`
+	ScoopProbability  float64 `protobuf:"fixed64,5,opt,name=scoop_probability,json=scoopProbability,proto3" json:"scoop_probability,omitempty"` // Chance of winning both halves alone
+}
+
+func (x *DoubleBoardEquity) Reset() {
+	*x = DoubleBoardEquity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DoubleBoardEquity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoubleBoardEquity) ProtoMessage() {}
+
+func (x *DoubleBoardEquity) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoubleBoardEquity.ProtoReflect.Descriptor instead.
+func (*DoubleBoardEquity) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *DoubleBoardEquity) GetSeat() int32 {
+	if x != nil {
+		return x.Seat
+	}
+	return 0
+}
+
+func (x *DoubleBoardEquity) GetEquity() float64 {
+	if x != nil {
+		return x.Equity
+	}
+	return 0
+}
+
+func (x *DoubleBoardEquity) GetTopBoardEquity() float64 {
+	if x != nil {
+		return x.TopBoardEquity
+	}
+	return 0
+}
+
+func (x *DoubleBoardEquity) GetBottomBoardEquity() float64 {
+	if x != nil {
+		return x.BottomBoardEquity
+	}
+	return 0
+}
+
+func (x *DoubleBoardEquity) GetScoopProbability() float64 {
+	if x != nil {
+		return x.ScoopProbability
+	}
+	return 0
+}
+
+type DiscardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HoleCards      []string  `protobuf:"bytes,1,rep,name=hole_cards,json=holeCards,proto3" json:"hole_cards,omitempty"`                 // The 3 cards dealt
+	CommunityCards []string  `protobuf:"bytes,2,rep,name=community_cards,json=communityCards,proto3" json:"community_cards,omitempty"`  // None, or the flop in Crazy Pineapple
+	Game           string    `protobuf:"bytes,3,opt,name=game,proto3" json:"game,omitempty"`                                            // "pineapple" (default) or "crazy-pineapple"
+	NumSimulations int32     `protobuf:"varint,4,opt,name=num_simulations,json=numSimulations,proto3" json:"num_simulations,omitempty"` // Per option
+	Estimator      Estimator `protobuf:"varint,5,opt,name=estimator,proto3,enum=poker.Estimator" json:"estimator,omitempty"`
+}
+
+func (x *DiscardRequest) Reset() {
+	*x = DiscardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiscardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscardRequest) ProtoMessage() {}
+
+func (x *DiscardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscardRequest.ProtoReflect.Descriptor instead.
+func (*DiscardRequest) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *DiscardRequest) GetHoleCards() []string {
+	if x != nil {
+		return x.HoleCards
+	}
+	return nil
+}
+
+func (x *DiscardRequest) GetCommunityCards() []string {
+	if x != nil {
+		return x.CommunityCards
+	}
+	return nil
+}
+
+func (x *DiscardRequest) GetGame() string {
+	if x != nil {
+		return x.Game
+	}
+	return ""
+}
+
+func (x *DiscardRequest) GetNumSimulations() int32 {
+	if x != nil {
+		return x.NumSimulations
+	}
+	return 0
+}
+
+func (x *DiscardRequest) GetEstimator() Estimator {
+	if x != nil {
+		return x.Estimator
+	}
+	return Estimator_ESTIMATOR_NAIVE
+}
+
+type DiscardResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DiscardCard string           `protobuf:"bytes,1,opt,name=discard_card,json=discardCard,proto3" json:"discard_card,omitempty"` // The recommended discard
+	Options     []*DiscardOption `protobuf:"bytes,2,rep,name=options,proto3" json:"options,omitempty"`                            // Best first
+}
+
+func (x *DiscardResponse) Reset() {
+	*x = DiscardResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiscardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscardResponse) ProtoMessage() {}
+
+func (x *DiscardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscardResponse.ProtoReflect.Descriptor instead.
+func (*DiscardResponse) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *DiscardResponse) GetDiscardCard() string {
+	if x != nil {
+		return x.DiscardCard
+	}
+	return ""
+}
+
+func (x *DiscardResponse) GetOptions() []*DiscardOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type DiscardOption struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DiscardCard    string        `protobuf:"bytes,1,opt,name=discard_card,json=discardCard,proto3" json:"discard_card,omitempty"`
+	KeepCards      []string      `protobuf:"bytes,2,rep,name=keep_cards,json=keepCards,proto3" json:"keep_cards,omitempty"`
+	Equity         float64       `protobuf:"fixed64,3,opt,name=equity,proto3" json:"equity,omitempty"` // Win plus half of ties against a random hand
+	WinProbability float64       `protobuf:"fixed64,4,opt,name=win_probability,json=winProbability,proto3" json:"win_probability,omitempty"`
+	TieProbability float64       `protobuf:"fixed64,5,opt,name=tie_probability,json=tieProbability,proto3" json:"tie_probability,omitempty"`
+	StandardError  float64       `protobuf:"fixed64,6,opt,name=standard_error,json=standardError,proto3" json:"standard_error,omitempty"`
+	Hand           *HandResponse `protobuf:"bytes,7,opt,name=hand,proto3" json:"hand,omitempty"` // The kept cards evaluated as Hold'em
+}
+
+func (x *DiscardOption) Reset() {
+	*x = DiscardOption{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiscardOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscardOption) ProtoMessage() {}
+
+func (x *DiscardOption) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscardOption.ProtoReflect.Descriptor instead.
+func (*DiscardOption) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *DiscardOption) GetDiscardCard() string {
+	if x != nil {
+		return x.DiscardCard
+	}
+	return ""
+}
+
+func (x *DiscardOption) GetKeepCards() []string {
+	if x != nil {
+		return x.KeepCards
+	}
+	return nil
+}
+
+func (x *DiscardOption) GetEquity() float64 {
+	if x != nil {
+		return x.Equity
+	}
+	return 0
+}
+
+func (x *DiscardOption) GetWinProbability() float64 {
+	if x != nil {
+		return x.WinProbability
+	}
+	return 0
+}
+
+func (x *DiscardOption) GetTieProbability() float64 {
+	if x != nil {
+		return x.TieProbability
+	}
+	return 0
+}
+
+func (x *DiscardOption) GetStandardError() float64 {
+	if x != nil {
+		return x.StandardError
+	}
+	return 0
+}
+
+func (x *DiscardOption) GetHand() *HandResponse {
+	if x != nil {
+		return x.Hand
+	}
+	return nil
+}
+
+type OFCRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Players []*OFCArrangement `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
+}
+
+func (x *OFCRequest) Reset() {
+	*x = OFCRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OFCRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OFCRequest) ProtoMessage() {}
+
+func (x *OFCRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OFCRequest.ProtoReflect.Descriptor instead.
+func (*OFCRequest) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *OFCRequest) GetPlayers() []*OFCArrangement {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+type OFCArrangement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seat        int32    `protobuf:"varint,1,opt,name=seat,proto3" json:"seat,omitempty"`
+	TopCards    []string `protobuf:"bytes,2,rep,name=top_cards,json=topCards,proto3" json:"top_cards,omitempty"`          // 3 cards
+	MiddleCards []string `protobuf:"bytes,3,rep,name=middle_cards,json=middleCards,proto3" json:"middle_cards,omitempty"` // 5 cards
+	BottomCards []string `protobuf:"bytes,4,rep,name=bottom_cards,json=bottomCards,proto3" json:"bottom_cards,omitempty"` // 5 cards
+}
+
+func (x *OFCArrangement) Reset() {
+	*x = OFCArrangement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OFCArrangement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OFCArrangement) ProtoMessage() {}
+
+func (x *OFCArrangement) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OFCArrangement.ProtoReflect.Descriptor instead.
+func (*OFCArrangement) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *OFCArrangement) GetSeat() int32 {
+	if x != nil {
+		return x.Seat
+	}
+	return 0
+}
+
+func (x *OFCArrangement) GetTopCards() []string {
+	if x != nil {
+		return x.TopCards
+	}
+	return nil
+}
+
+func (x *OFCArrangement) GetMiddleCards() []string {
+	if x != nil {
+		return x.MiddleCards
+	}
+	return nil
+}
+
+func (x *OFCArrangement) GetBottomCards() []string {
+	if x != nil {
+		return x.BottomCards
+	}
+	return nil
+}
+
+type OFCResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*OFCResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // In request order
+}
+
+func (x *OFCResponse) Reset() {
+	*x = OFCResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OFCResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OFCResponse) ProtoMessage() {}
+
+func (x *OFCResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OFCResponse.ProtoReflect.Descriptor instead.
+func (*OFCResponse) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *OFCResponse) GetResults() []*OFCResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type OFCResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seat             int32         `protobuf:"varint,1,opt,name=seat,proto3" json:"seat,omitempty"`
+	Rows             []*OFCRow     `protobuf:"bytes,2,rep,name=rows,proto3" json:"rows,omitempty"`      // Top, middle, bottom
+	Fouled           bool          `protobuf:"varint,3,opt,name=fouled,proto3" json:"fouled,omitempty"` // Rows do not rank bottom >= middle >= top
+	Royalties        int32         `protobuf:"varint,4,opt,name=royalties,proto3" json:"royalties,omitempty"`
+	Fantasyland      bool          `protobuf:"varint,5,opt,name=fantasyland,proto3" json:"fantasyland,omitempty"`                                   // Queens or better on top without fouling
+	FantasylandCards int32         `protobuf:"varint,6,opt,name=fantasyland_cards,json=fantasylandCards,proto3" json:"fantasyland_cards,omitempty"` // 14 for QQ, 15 for KK, 16 for AA, 17 for trips
+	Matchups         []*OFCMatchup `protobuf:"bytes,7,rep,name=matchups,proto3" json:"matchups,omitempty"`
+	Points           int32         `protobuf:"varint,8,opt,name=points,proto3" json:"points,omitempty"` // Net points against every opponent
+}
+
+func (x *OFCResult) Reset() {
+	*x = OFCResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OFCResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OFCResult) ProtoMessage() {}
+
+func (x *OFCResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OFCResult.ProtoReflect.Descriptor instead.
+func (*OFCResult) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *OFCResult) GetSeat() int32 {
+	if x != nil {
+		return x.Seat
+	}
+	return 0
+}
+
+func (x *OFCResult) GetRows() []*OFCRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *OFCResult) GetFouled() bool {
+	if x != nil {
+		return x.Fouled
+	}
+	return false
+}
+
+func (x *OFCResult) GetRoyalties() int32 {
+	if x != nil {
+		return x.Royalties
+	}
+	return 0
+}
+
+func (x *OFCResult) GetFantasyland() bool {
+	if x != nil {
+		return x.Fantasyland
+	}
+	return false
+}
+
+func (x *OFCResult) GetFantasylandCards() int32 {
+	if x != nil {
+		return x.FantasylandCards
+	}
+	return 0
+}
+
+func (x *OFCResult) GetMatchups() []*OFCMatchup {
+	if x != nil {
+		return x.Matchups
+	}
+	return nil
+}
+
+func (x *OFCResult) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+type OFCRow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // "top", "middle" or "bottom"
+	Hand        *HandResponse `protobuf:"bytes,2,opt,name=hand,proto3" json:"hand,omitempty"`
+	Description string        `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"` // e.g. "One Pair, Queens (7 kicker)"
+	Royalty     int32         `protobuf:"varint,4,opt,name=royalty,proto3" json:"royalty,omitempty"`
+}
+
+func (x *OFCRow) Reset() {
+	*x = OFCRow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OFCRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OFCRow) ProtoMessage() {}
+
+func (x *OFCRow) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OFCRow.ProtoReflect.Descriptor instead.
+func (*OFCRow) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *OFCRow) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OFCRow) GetHand() *HandResponse {
+	if x != nil {
+		return x.Hand
+	}
+	return nil
+}
+
+func (x *OFCRow) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *OFCRow) GetRoyalty() int32 {
+	if x != nil {
+		return x.Royalty
+	}
+	return 0
+}
+
+type OFCMatchup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Opponent int32   `protobuf:"varint,1,opt,name=opponent,proto3" json:"opponent,omitempty"`
+	Rows     []int32 `protobuf:"varint,2,rep,packed,name=rows,proto3" json:"rows,omitempty"` // 1 won, 0 tied, -1 lost; top first
+	Scoop    bool    `protobuf:"varint,3,opt,name=scoop,proto3" json:"scoop,omitempty"`      // Won all three rows
+	Points   int32   `protobuf:"varint,4,opt,name=points,proto3" json:"points,omitempty"`    // Rows, scoop bonus and the royalty difference
+}
+
+func (x *OFCMatchup) Reset() {
+	*x = OFCMatchup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OFCMatchup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OFCMatchup) ProtoMessage() {}
+
+func (x *OFCMatchup) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OFCMatchup.ProtoReflect.Descriptor instead.
+func (*OFCMatchup) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *OFCMatchup) GetOpponent() int32 {
+	if x != nil {
+		return x.Opponent
+	}
+	return 0
+}
+
+func (x *OFCMatchup) GetRows() []int32 {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *OFCMatchup) GetScoop() bool {
+	if x != nil {
+		return x.Scoop
+	}
+	return false
+}
+
+func (x *OFCMatchup) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+type ICMRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stacks         []float64 `protobuf:"fixed64,1,rep,packed,name=stacks,proto3" json:"stacks,omitempty"`   // Chip stacks, one per player
+	Payouts        []float64 `protobuf:"fixed64,2,rep,packed,name=payouts,proto3" json:"payouts,omitempty"` // Prize for 1st, 2nd, ... place
+	Method         ICMMethod `protobuf:"varint,3,opt,name=method,proto3,enum=poker.ICMMethod" json:"method,omitempty"`
+	NumSimulations int32     `protobuf:"varint,4,opt,name=num_simulations,json=numSimulations,proto3" json:"num_simulations,omitempty"` // Samples for ICM_MONTE_CARLO
+}
+
+func (x *ICMRequest) Reset() {
+	*x = ICMRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ICMRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ICMRequest) ProtoMessage() {}
+
+func (x *ICMRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ICMRequest.ProtoReflect.Descriptor instead.
+func (*ICMRequest) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ICMRequest) GetStacks() []float64 {
+	if x != nil {
+		return x.Stacks
+	}
+	return nil
+}
+
+func (x *ICMRequest) GetPayouts() []float64 {
+	if x != nil {
+		return x.Payouts
+	}
+	return nil
+}
+
+func (x *ICMRequest) GetMethod() ICMMethod {
+	if x != nil {
+		return x.Method
+	}
+	return ICMMethod_ICM_AUTO
+}
+
+func (x *ICMRequest) GetNumSimulations() int32 {
+	if x != nil {
+		return x.NumSimulations
+	}
+	return 0
+}
+
+type ICMResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Equities   []float64             `protobuf:"fixed64,1,rep,packed,name=equities,proto3" json:"equities,omitempty"` // $EV per player, same order as stacks
+	Places     []*PlaceProbabilities `protobuf:"bytes,2,rep,name=places,proto3" json:"places,omitempty"`              // Finishing distribution per player
+	MethodUsed ICMMethod             `protobuf:"varint,3,opt,name=method_used,json=methodUsed,proto3,enum=poker.ICMMethod" json:"method_used,omitempty"`
+}
+
+func (x *ICMResponse) Reset() {
+	*x = ICMResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ICMResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ICMResponse) ProtoMessage() {}
+
+func (x *ICMResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ICMResponse.ProtoReflect.Descriptor instead.
+func (*ICMResponse) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ICMResponse) GetEquities() []float64 {
+	if x != nil {
+		return x.Equities
+	}
+	return nil
+}
+
+func (x *ICMResponse) GetPlaces() []*PlaceProbabilities {
+	if x != nil {
+		return x.Places
+	}
+	return nil
+}
+
+func (x *ICMResponse) GetMethodUsed() ICMMethod {
+	if x != nil {
+		return x.MethodUsed
+	}
+	return ICMMethod_ICM_AUTO
+}
+
+type PlaceProbabilities struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Probabilities []float64 `protobuf:"fixed64,1,rep,packed,name=probabilities,proto3" json:"probabilities,omitempty"` // Probability of finishing 1st, 2nd, ... (paid places only)
+}
+
+func (x *PlaceProbabilities) Reset() {
+	*x = PlaceProbabilities{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlaceProbabilities) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceProbabilities) ProtoMessage() {}
+
+func (x *PlaceProbabilities) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceProbabilities.ProtoReflect.Descriptor instead.
+func (*PlaceProbabilities) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *PlaceProbabilities) GetProbabilities() []float64 {
+	if x != nil {
+		return x.Probabilities
+	}
+	return nil
+}
+
+type PushFoldRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stacks           []float64 `protobuf:"fixed64,1,rep,packed,name=stacks,proto3" json:"stacks,omitempty"` // Chip stacks before the hand is dealt
+	Payouts          []float64 `protobuf:"fixed64,2,rep,packed,name=payouts,proto3" json:"payouts,omitempty"`
+	HeroSeat         int32     `protobuf:"varint,3,opt,name=hero_seat,json=heroSeat,proto3" json:"hero_seat,omitempty"`                          // Index into stacks of the player deciding to push
+	VillainSeat      int32     `protobuf:"varint,4,opt,name=villain_seat,json=villainSeat,proto3" json:"villain_seat,omitempty"`                 // Index into stacks of the player left to call
+	HoleCards        []string  `protobuf:"bytes,5,rep,name=hole_cards,json=holeCards,proto3" json:"hole_cards,omitempty"`                        // Hero's hole cards, e.g. ["HA", "SK"]
+	VillainCallRange string    `protobuf:"bytes,6,opt,name=villain_call_range,json=villainCallRange,proto3" json:"villain_call_range,omitempty"` // e.g. "22+,A2s+,ATo+,KQ"
+	SmallBlind       float64   `protobuf:"fixed64,7,opt,name=small_blind,json=smallBlind,proto3" json:"small_blind,omitempty"`                   // Posted by hero
+	BigBlind         float64   `protobuf:"fixed64,8,opt,name=big_blind,json=bigBlind,proto3" json:"big_blind,omitempty"`                         // Posted by villain
+	Ante             float64   `protobuf:"fixed64,9,opt,name=ante,proto3" json:"ante,omitempty"`                                                 // Posted by every player
+	NumSimulations   int32     `protobuf:"varint,10,opt,name=num_simulations,json=numSimulations,proto3" json:"num_simulations,omitempty"`       // Monte Carlo trials for hero's equity
+}
+
+func (x *PushFoldRequest) Reset() {
+	*x = PushFoldRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushFoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushFoldRequest) ProtoMessage() {}
+
+func (x *PushFoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushFoldRequest.ProtoReflect.Descriptor instead.
+func (*PushFoldRequest) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *PushFoldRequest) GetStacks() []float64 {
+	if x != nil {
+		return x.Stacks
+	}
+	return nil
+}
+
+func (x *PushFoldRequest) GetPayouts() []float64 {
+	if x != nil {
+		return x.Payouts
+	}
+	return nil
+}
+
+func (x *PushFoldRequest) GetHeroSeat() int32 {
+	if x != nil {
+		return x.HeroSeat
+	}
+	return 0
+}
+
+func (x *PushFoldRequest) GetVillainSeat() int32 {
+	if x != nil {
+		return x.VillainSeat
+	}
+	return 0
+}
+
+func (x *PushFoldRequest) GetHoleCards() []string {
+	if x != nil {
+		return x.HoleCards
+	}
+	return nil
+}
+
+func (x *PushFoldRequest) GetVillainCallRange() string {
+	if x != nil {
+		return x.VillainCallRange
+	}
+	return ""
+}
+
+func (x *PushFoldRequest) GetSmallBlind() float64 {
+	if x != nil {
+		return x.SmallBlind
+	}
+	return 0
+}
+
+func (x *PushFoldRequest) GetBigBlind() float64 {
+	if x != nil {
+		return x.BigBlind
+	}
+	return 0
+}
+
+func (x *PushFoldRequest) GetAnte() float64 {
+	if x != nil {
+		return x.Ante
+	}
+	return 0
+}
+
+func (x *PushFoldRequest) GetNumSimulations() int32 {
+	if x != nil {
+		return x.NumSimulations
+	}
+	return 0
+}
+
+type PushFoldResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Decision         string  `protobuf:"bytes,1,opt,name=decision,proto3" json:"decision,omitempty"`                                             // "PUSH" or "FOLD"
+	PushEv           float64 `protobuf:"fixed64,2,opt,name=push_ev,json=pushEv,proto3" json:"push_ev,omitempty"`                                 // Hero's $EV when pushing
+	FoldEv           float64 `protobuf:"fixed64,3,opt,name=fold_ev,json=foldEv,proto3" json:"fold_ev,omitempty"`                                 // Hero's $EV when folding
+	CallProbability  float64 `protobuf:"fixed64,4,opt,name=call_probability,json=callProbability,proto3" json:"call_probability,omitempty"`      // How often villain's range calls
+	EquityWhenCalled float64 `protobuf:"fixed64,5,opt,name=equity_when_called,json=equityWhenCalled,proto3" json:"equity_when_called,omitempty"` // Hero's showdown equity against the calling range
+	PushChipEv       float64 `protobuf:"fixed64,6,opt,name=push_chip_ev,json=pushChipEv,proto3" json:"push_chip_ev,omitempty"`                   // Hero's expected stack when pushing
+	FoldChipEv       float64 `protobuf:"fixed64,7,opt,name=fold_chip_ev,json=foldChipEv,proto3" json:"fold_chip_ev,omitempty"`                   // Hero's expected stack when folding
+}
+
+func (x *PushFoldResponse) Reset() {
+	*x = PushFoldResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_poker_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushFoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushFoldResponse) ProtoMessage() {}
+
+func (x *PushFoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_poker_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushFoldResponse.ProtoReflect.Descriptor instead.
+func (*PushFoldResponse) Descriptor() ([]byte, []int) {
+	return file_proto_poker_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *PushFoldResponse) GetDecision() string {
+	if x != nil {
+		return x.Decision
+	}
+	return ""
+}
+
+func (x *PushFoldResponse) GetPushEv() float64 {
+	if x != nil {
+		return x.PushEv
+	}
+	return 0
+}
+
+func (x *PushFoldResponse) GetFoldEv() float64 {
+	if x != nil {
+		return x.FoldEv
+	}
+	return 0
+}
+
+func (x *PushFoldResponse) GetCallProbability() float64 {
+	if x != nil {
+		return x.CallProbability
+	}
+	return 0
+}
+
+func (x *PushFoldResponse) GetEquityWhenCalled() float64 {
+	if x != nil {
+		return x.EquityWhenCalled
+	}
+	return 0
+}
+
+func (x *PushFoldResponse) GetPushChipEv() float64 {
+	if x != nil {
+		return x.PushChipEv
+	}
+	return 0
+}
+
+func (x *PushFoldResponse) GetFoldChipEv() float64 {
+	if x != nil {
+		return x.FoldChipEv
+	}
+	return 0
+}
+
+var File_proto_poker_proto protoreflect.FileDescriptor
+
+var file_proto_poker_proto_rawDesc = []byte{
+	0x0a, 0x11, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x22, 0x95, 0x01, 0x0a, 0x0b, 0x48,
+	0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x6f,
+	0x6c, 0x65, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09,
+	0x68, 0x6f, 0x6c, 0x65, 0x43, 0x61, 0x72, 0x64, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x43, 0x61, 0x72,
+	0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x04,
+	0x77, 0x69, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x6f, 0x6b,
+	0x65, 0x72, 0x2e, 0x57, 0x69, 0x6c, 0x64, 0x43, 0x61, 0x72, 0x64, 0x73, 0x52, 0x04, 0x77, 0x69,
+	0x6c, 0x64, 0x22, 0x52, 0x0a, 0x09, 0x57, 0x69, 0x6c, 0x64, 0x43, 0x61, 0x72, 0x64, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x6a, 0x6f, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x6a, 0x6f, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x75, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x62, 0x75, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x69, 0x6c,
+	0x64, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x77, 0x69,
+	0x6c, 0x64, 0x52, 0x61, 0x6e, 0x6b, 0x22, 0x46, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x73, 0x74, 0x69,
+	0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x69, 0x6c, 0x64, 0x5f, 0x63,
+	0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x69, 0x6c, 0x64, 0x43,
+	0x61, 0x72, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x73, 0x5f, 0x61, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x73, 0x41, 0x73, 0x22, 0xd7,
+	0x03, 0x0a, 0x0c, 0x48, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x24, 0x0a, 0x0e, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x61, 0x6e, 0x64, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x65, 0x73, 0x74, 0x48, 0x61, 0x6e,
+	0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x68, 0x61, 0x6e, 0x64, 0x5f, 0x72, 0x61,
+	0x6e, 0x6b, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d,
+	0x68, 0x61, 0x6e, 0x64, 0x52, 0x61, 0x6e, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x09, 0x62, 0x65, 0x73, 0x74, 0x43, 0x61, 0x72, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x05,
+	0x64, 0x72, 0x61, 0x77, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x6f,
+	0x6b, 0x65, 0x72, 0x2e, 0x44, 0x72, 0x61, 0x77, 0x52, 0x05, 0x64, 0x72, 0x61, 0x77, 0x73, 0x12,
+	0x2b, 0x0a, 0x11, 0x65, 0x71, 0x75, 0x69, 0x76, 0x61, 0x6c, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x63,
+	0x6c, 0x61, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x65, 0x71, 0x75, 0x69,
+	0x76, 0x61, 0x6c, 0x65, 0x6e, 0x63, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x32, 0x0a, 0x15,
+	0x66, 0x69, 0x76, 0x65, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x66, 0x69, 0x76,
+	0x65, 0x43, 0x61, 0x72, 0x64, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x12, 0x34, 0x0a, 0x16, 0x73, 0x65, 0x76, 0x65, 0x6e, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x70,
+	0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x14, 0x73, 0x65, 0x76, 0x65, 0x6e, 0x43, 0x61, 0x72, 0x64, 0x50, 0x72, 0x6f, 0x62, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x69, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x61, 0x6e, 0x64, 0x5f, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x68, 0x61, 0x6e, 0x64,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x26, 0x0a, 0x0f, 0x68, 0x6f, 0x6c, 0x65, 0x5f, 0x63, 0x61,
+	0x72, 0x64, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d,
+	0x68, 0x6f, 0x6c, 0x65, 0x43, 0x61, 0x72, 0x64, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x39, 0x0a,
+	0x0d, 0x73, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0b,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x62,
+	0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x74,
+	0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x2e, 0x0a, 0x04, 0x44, 0x72, 0x61, 0x77,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6f, 0x75, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x04, 0x6f, 0x75, 0x74, 0x73, 0x22, 0x64, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x70,
+	0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x05, 0x68, 0x61,
+	0x6e, 0x64, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x6f, 0x6b, 0x65,
+	0x72, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x05, 0x68,
+	0x61, 0x6e, 0x64, 0x31, 0x12, 0x28, 0x0a, 0x05, 0x68, 0x61, 0x6e, 0x64, 0x32, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x48, 0x61, 0x6e, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x05, 0x68, 0x61, 0x6e, 0x64, 0x32, 0x22, 0xc7,
+	0x01, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x0c, 0x68, 0x61,
+	0x6e, 0x64, 0x31, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0b, 0x68, 0x61, 0x6e, 0x64, 0x31, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x36, 0x0a, 0x0c, 0x68, 0x61, 0x6e, 0x64, 0x32, 0x5f, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72,
+	0x2e, 0x48, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0b, 0x68,
+	0x61, 0x6e, 0x64, 0x32, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2c, 0x0a, 0x09, 0x74, 0x69,
+	0x65, 0x5f, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x54, 0x69, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x52, 0x08,
+	0x74, 0x69, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x22, 0x95, 0x02, 0x0a, 0x08, 0x54, 0x69, 0x65,
+	0x42, 0x72, 0x65, 0x61, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x6b,
+	0x69, 0x63, 0x6b, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6b, 0x69, 0x63,
+	0x6b, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x61, 0x6e, 0x64, 0x31, 0x5f, 0x63, 0x61, 0x72,
+	0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x61, 0x6e, 0x64, 0x31, 0x43,
+	0x61, 0x72, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x61, 0x6e, 0x64, 0x32, 0x5f, 0x63, 0x61,
+	0x72, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x61, 0x6e, 0x64, 0x32,
+	0x43, 0x61, 0x72, 0x64, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x70,
+	0x6c, 0x61, 0x79, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x50, 0x6c, 0x61, 0x79, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x68, 0x61, 0x6e, 0x64, 0x31, 0x5f,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0f, 0x68, 0x61, 0x6e, 0x64, 0x31, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x50, 0x6c, 0x61,
+	0x79, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x68, 0x61, 0x6e, 0x64, 0x32, 0x5f, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x68,
+	0x61, 0x6e, 0x64, 0x32, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x73, 0x12, 0x20,
+	0x0a, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0xed, 0x01, 0x0a, 0x0a, 0x53, 0x69, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x68, 0x6f, 0x6c, 0x65, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x09, 0x68, 0x6f, 0x6c, 0x65, 0x43, 0x61, 0x72, 0x64, 0x73, 0x12, 0x27,
+	0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x61, 0x72, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69,
+	0x74, 0x79, 0x43, 0x61, 0x72, 0x64, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x6e, 0x75, 0x6d, 0x5f, 0x73,
+	0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0e, 0x6e, 0x75, 0x6d, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x2e, 0x0a, 0x09, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x45, 0x73, 0x74, 0x69,
+	0x6d, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x09, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x04, 0x77, 0x69,
+	0x6c, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72,
+	0x2e, 0x57, 0x69, 0x6c, 0x64, 0x43, 0x61, 0x72, 0x64, 0x73, 0x52, 0x04, 0x77, 0x69, 0x6c, 0x64,
+	0x22, 0x93, 0x02, 0x0a, 0x0b, 0x53, 0x69, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x27, 0x0a, 0x0f, 0x77, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x77, 0x69, 0x6e, 0x50, 0x72,
+	0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x69, 0x65,
+	0x5f, 0x70, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0e, 0x74, 0x69, 0x65, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x6c, 0x6f,
+	0x73, 0x65, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x27, 0x0a,
+	0x0f, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x72, 0x75, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x75, 0x6e, 0x12, 0x37, 0x0a, 0x0e, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61,
+	0x74, 0x6f, 0x72, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10,
+	0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x6f, 0x72,
+	0x52, 0x0d, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x64, 0x12,
+	0x25, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x72, 0x64, 0x5f, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x72,
+	0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x82, 0x01, 0x0a, 0x13, 0x48, 0x61, 0x6e, 0x64, 0x53,
+	0x74, 0x72, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x68, 0x6f, 0x6c, 0x65, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x09, 0x68, 0x6f, 0x6c, 0x65, 0x43, 0x61, 0x72, 0x64, 0x73, 0x12, 0x27, 0x0a,
+	0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74,
+	0x79, 0x43, 0x61, 0x72, 0x64, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x75, 0x6d, 0x5f, 0x6f, 0x70,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6e,
+	0x75, 0x6d, 0x4f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xf4, 0x02, 0x0a, 0x14,
+	0x48, 0x61, 0x6e, 0x64, 0x53, 0x74, 0x72, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x68, 0x61, 0x6e, 0x64, 0x5f, 0x73, 0x74, 0x72,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x68, 0x61, 0x6e,
+	0x64, 0x53, 0x74, 0x72, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x50,
+	0x6f, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x2d, 0x0a, 0x12, 0x6e, 0x65, 0x67, 0x61,
+	0x74, 0x69, 0x76, 0x65, 0x5f, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x6e, 0x65, 0x67, 0x61, 0x74, 0x69, 0x76, 0x65, 0x50, 0x6f,
+	0x74, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x36, 0x0a, 0x17, 0x65, 0x66, 0x66, 0x65, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x5f, 0x68, 0x61, 0x6e, 0x64, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x6e, 0x67,
+	0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x15, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x53, 0x74, 0x72, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12,
+	0x38, 0x0a, 0x18, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x73, 0x74, 0x69, 0x63, 0x5f, 0x68, 0x61,
+	0x6e, 0x64, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x16, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x73, 0x74, 0x69, 0x63, 0x48, 0x61, 0x6e,
+	0x64, 0x53, 0x74, 0x72, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6d,
+	0x62, 0x6f, 0x73, 0x5f, 0x61, 0x68, 0x65, 0x61, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0b, 0x63, 0x6f, 0x6d, 0x62, 0x6f, 0x73, 0x41, 0x68, 0x65, 0x61, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x63, 0x6f, 0x6d, 0x62, 0x6f, 0x73, 0x5f, 0x74, 0x69, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x62, 0x6f, 0x73, 0x54, 0x69, 0x65, 0x64, 0x12, 0x23, 0x0a,
+	0x0d, 0x63, 0x6f, 0x6d, 0x62, 0x6f, 0x73, 0x5f, 0x62, 0x65, 0x68, 0x69, 0x6e, 0x64, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x62, 0x6f, 0x73, 0x42, 0x65, 0x68, 0x69,
+	0x6e, 0x64, 0x22, 0x37, 0x0a, 0x0c, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x5f,
+	0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x43, 0x61, 0x72, 0x64, 0x73, 0x22, 0xf3, 0x03, 0x0a, 0x14,
+	0x42, 0x6f, 0x61, 0x72, 0x64, 0x54, 0x65, 0x78, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x61, 0x69, 0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x70, 0x61, 0x69, 0x72, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x72, 0x69, 0x70, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x74, 0x72, 0x69, 0x70, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x73, 0x75, 0x69, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x73, 0x75, 0x69, 0x74, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12,
+	0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x75, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x53, 0x75, 0x69, 0x74,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x6c, 0x75, 0x73, 0x68, 0x5f, 0x70,
+	0x6f, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x66,
+	0x6c, 0x75, 0x73, 0x68, 0x50, 0x6f, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x12, 0x22, 0x0a, 0x0c,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
+	0x12, 0x29, 0x0a, 0x10, 0x73, 0x74, 0x72, 0x61, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x77, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x73, 0x74, 0x72, 0x61,
+	0x69, 0x67, 0x68, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x73,
+	0x74, 0x72, 0x61, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x70, 0x6f, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x73, 0x74, 0x72, 0x61, 0x69, 0x67, 0x68, 0x74,
+	0x50, 0x6f, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x69, 0x67, 0x68,
+	0x5f, 0x63, 0x61, 0x72, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x69, 0x67,
+	0x68, 0x43, 0x61, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x65, 0x74, 0x6e, 0x65, 0x73, 0x73,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x77, 0x65, 0x74, 0x6e, 0x65, 0x73, 0x73, 0x12,
+	0x37, 0x0a, 0x0e, 0x70, 0x6f, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x5f, 0x68, 0x61, 0x6e, 0x64,
+	0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e,
+	0x48, 0x61, 0x6e, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0d, 0x70, 0x6f, 0x73, 0x73, 0x69,
+	0x62, 0x6c, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x37, 0x0a, 0x0e, 0x70, 0x6f, 0x73, 0x73,
+	0x69, 0x62, 0x6c, 0x65, 0x5f, 0x64, 0x72, 0x61, 0x77, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x0d, 0x70, 0x6f, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x44, 0x72, 0x61, 0x77,
+	0x73, 0x22, 0x37, 0x0a, 0x09, 0x48, 0x61, 0x6e, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x62, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x62, 0x6f, 0x73, 0x22, 0x76, 0x0a, 0x0b, 0x4e, 0x75,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x43, 0x61, 0x72,
+	0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x6f, 0x6c, 0x65, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x68, 0x6f, 0x6c, 0x65, 0x43, 0x61, 0x72, 0x64,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x43, 0x6c, 0x61, 0x73, 0x73,
+	0x65, 0x73, 0x22, 0x9d, 0x02, 0x0a, 0x0c, 0x4e, 0x75, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x0b, 0x6e, 0x75, 0x74, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72,
+	0x2e, 0x4e, 0x75, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x0a, 0x6e, 0x75, 0x74, 0x43, 0x6c,
+	0x61, 0x73, 0x73, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63,
+	0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x68, 0x65,
+	0x72, 0x6f, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0d, 0x68, 0x65, 0x72, 0x6f, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x61,
+	0x6e, 0x6b, 0x12, 0x2d, 0x0a, 0x0a, 0x62, 0x65, 0x61, 0x74, 0x73, 0x5f, 0x68, 0x65, 0x72, 0x6f,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x48,
+	0x6f, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x62, 0x65, 0x61, 0x74, 0x73, 0x48, 0x65, 0x72,
+	0x6f, 0x12, 0x2b, 0x0a, 0x09, 0x74, 0x69, 0x65, 0x73, 0x5f, 0x68, 0x65, 0x72, 0x6f, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x48, 0x6f, 0x6c,
+	0x64, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x74, 0x69, 0x65, 0x73, 0x48, 0x65, 0x72, 0x6f, 0x12, 0x32,
+	0x0a, 0x0d, 0x6c, 0x6f, 0x73, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x68, 0x65, 0x72, 0x6f, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x48, 0x6f,
+	0x6c, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x0b, 0x6c, 0x6f, 0x73, 0x65, 0x73, 0x54, 0x6f, 0x48, 0x65,
+	0x72, 0x6f, 0x22, 0xc9, 0x01, 0x0a, 0x08, 0x4e, 0x75, 0x74, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72,
+	0x61, 0x6e, 0x6b, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x61, 0x6e, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x61, 0x6e, 0x64, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x68, 0x61, 0x6e, 0x64, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x68, 0x61, 0x6e,
+	0x64, 0x52, 0x61, 0x6e, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f,
+	0x6d, 0x62, 0x6f, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x62,
+	0x6f, 0x73, 0x12, 0x2a, 0x0a, 0x08, 0x68, 0x6f, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x48, 0x6f, 0x6c,
+	0x64, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x68, 0x6f, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x1f,
+	0x0a, 0x07, 0x48, 0x6f, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x61, 0x72,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x63, 0x61, 0x72, 0x64, 0x73, 0x22,
+	0x7f, 0x0a, 0x0e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x6f, 0x6c, 0x65, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x68, 0x6f, 0x6c, 0x65, 0x43, 0x61, 0x72, 0x64, 0x73,
+	0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x61,
+	0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x75,
+	0x6e, 0x69, 0x74, 0x79, 0x43, 0x61, 0x72, 0x64, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x70, 0x70,
+	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x22, 0x9c, 0x01, 0x0a, 0x0f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x12, 0x2e, 0x0a, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x6d, 0x62, 0x6f,
+	0x73, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x6d, 0x62, 0x6f, 0x73, 0x42, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x6d, 0x62, 0x6f,
+	0x73, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x6d, 0x62, 0x6f, 0x73, 0x41, 0x66, 0x74, 0x65, 0x72, 0x22,
+	0x9d, 0x02, 0x0a, 0x0c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x12, 0x1b, 0x0a, 0x09, 0x68, 0x61, 0x6e, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x61, 0x6e, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a,
+	0x0d, 0x63, 0x6f, 0x6d, 0x62, 0x6f, 0x73, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x62, 0x6f, 0x73, 0x42, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x62, 0x6f, 0x73, 0x5f, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x62, 0x6f, 0x73,
+	0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64,
+	0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x27, 0x0a, 0x0f, 0x6e, 0x75, 0x74, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6e, 0x75, 0x74, 0x44, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x11, 0x6e, 0x75, 0x74,
+	0x5f, 0x63, 0x6f, 0x6d, 0x62, 0x6f, 0x73, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x6e, 0x75, 0x74, 0x43, 0x6f, 0x6d, 0x62, 0x6f, 0x73, 0x42,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x6e, 0x75, 0x74, 0x5f, 0x63, 0x6f, 0x6d,
+	0x62, 0x6f, 0x73, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0e, 0x6e, 0x75, 0x74, 0x43, 0x6f, 0x6d, 0x62, 0x6f, 0x73, 0x41, 0x66, 0x74, 0x65, 0x72, 0x22,
+	0x43, 0x0a, 0x0c, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x72, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x63, 0x61,
+	0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x61, 0x64, 0x43,
+	0x61, 0x72, 0x64, 0x73, 0x22, 0xad, 0x01, 0x0a, 0x15, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6c, 0x65, 0x66, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x65,
+	0x66, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x72, 0x69, 0x67, 0x68, 0x74, 0x12, 0x33, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x6f,
+	0x6b, 0x65, 0x72, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a,
+	0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x63, 0x61,
+	0x72, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x61, 0x64, 0x43,
+	0x61, 0x72, 0x64, 0x73, 0x22, 0x86, 0x01, 0x0a, 0x0d, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x62, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x62, 0x6f, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x70, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x04, 0x67, 0x72, 0x69, 0x64, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x47, 0x72, 0x69, 0x64, 0x52, 0x6f, 0x77, 0x52, 0x04, 0x67, 0x72, 0x69, 0x64, 0x22, 0x3a, 0x0a,
+	0x0c, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x47, 0x72, 0x69, 0x64, 0x52, 0x6f, 0x77, 0x12, 0x2a, 0x0a,
+	0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70,
+	0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x47, 0x72, 0x69, 0x64, 0x43, 0x65,
+	0x6c, 0x6c, 0x52, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x22, 0x7e, 0x0a, 0x0d, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x47, 0x72, 0x69, 0x64, 0x43, 0x65, 0x6c, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x62, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x06, 0x63, 0x6f, 0x6d, 0x62, 0x6f, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x63, 0x6f, 0x6d, 0x62, 0x6f, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x6d, 0x62, 0x6f, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x66,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09,
+	0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x89, 0x01, 0x0a, 0x0e, 0x48, 0x65,
+	0x61, 0x74, 0x6d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79,
+	0x43, 0x61, 0x72, 0x64, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6f,
+	0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x27, 0x0a, 0x0f,
+	0x6e, 0x75, 0x6d, 0x5f, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6e, 0x75, 0x6d, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x38, 0x0a, 0x0f, 0x48, 0x65, 0x61, 0x74, 0x6d, 0x61, 0x70,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x48,
+	0x65, 0x61, 0x74, 0x6d, 0x61, 0x70, 0x52, 0x6f, 0x77, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x22,
+	0x36, 0x0a, 0x0a, 0x48, 0x65, 0x61, 0x74, 0x6d, 0x61, 0x70, 0x52, 0x6f, 0x77, 0x12, 0x28, 0x0a,
+	0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70,
+	0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x48, 0x65, 0x61, 0x74, 0x6d, 0x61, 0x70, 0x43, 0x65, 0x6c, 0x6c,
+	0x52, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x22, 0x77, 0x0a, 0x0b, 0x48, 0x65, 0x61, 0x74, 0x6d,
+	0x61, 0x70, 0x43, 0x65, 0x6c, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06,
+	0x63, 0x6f, 0x6d, 0x62, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x6f,
+	0x6d, 0x62, 0x6f, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x77, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x03, 0x77, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x69, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x03, 0x74, 0x69, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x71, 0x75, 0x69,
+	0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x65, 0x71, 0x75, 0x69, 0x74, 0x79,
+	0x22, 0x64, 0x0a, 0x0f, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x48, 0x6f, 0x6c,
+	0x64, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x27, 0x0a,
+	0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74,
+	0x79, 0x43, 0x61, 0x72, 0x64, 0x73, 0x22, 0x41, 0x0a, 0x10, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x73, 0x74,
+	0x72, 0x65, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x6f,
+	0x6b, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x65, 0x74, 0x45, 0x71, 0x75, 0x69, 0x74, 0x79,
+	0x52, 0x07, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x73, 0x22, 0xb0, 0x01, 0x0a, 0x0c, 0x53, 0x74,
+	0x72, 0x65, 0x65, 0x74, 0x45, 0x71, 0x75, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x72, 0x65, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65,
+	0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x05, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f,
+	0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x65, 0x77,
+	0x43, 0x61, 0x72, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x71, 0x75, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x01, 0x52, 0x08, 0x65, 0x71, 0x75, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x65, 0x61,
+	0x64, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x6c, 0x65, 0x61, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x22, 0xa3, 0x01, 0x0a,
+	0x13, 0x46, 0x6c, 0x6f, 0x70, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x65, 0x72, 0x6f, 0x5f, 0x72, 0x61, 0x6e,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x68, 0x65, 0x72, 0x6f, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x69, 0x6c, 0x6c, 0x61, 0x69, 0x6e, 0x5f, 0x72,
+	0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x76, 0x69, 0x6c, 0x6c,
+	0x61, 0x69, 0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x73,
+	0x65, 0x74, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73,
+	0x75, 0x62, 0x73, 0x65, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6e, 0x75, 0x6d,
+	0x5f, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0e, 0x6e, 0x75, 0x6d, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0xdf, 0x01, 0x0a, 0x12, 0x46, 0x6c, 0x6f, 0x70, 0x41, 0x6e, 0x61, 0x6c, 0x79,
+	0x73, 0x69, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x66, 0x6c, 0x6f,
+	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e,
+	0x46, 0x6c, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x04, 0x66, 0x6c, 0x6f, 0x70,
+	0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x6c, 0x6f, 0x70, 0x73, 0x5f, 0x64, 0x6f, 0x6e, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x66, 0x6c, 0x6f, 0x70, 0x73, 0x44, 0x6f, 0x6e, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x66, 0x6c, 0x6f, 0x70, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x66, 0x6c, 0x6f, 0x70, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c,
+	0x12, 0x31, 0x0a, 0x08, 0x74, 0x65, 0x78, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x75,
+	0x72, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x08, 0x74, 0x65, 0x78, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x07, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x54, 0x65, 0x78,
+	0x74, 0x75, 0x72, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x07, 0x6f, 0x76, 0x65,
+	0x72, 0x61, 0x6c, 0x6c, 0x22, 0x94, 0x01, 0x0a, 0x0a, 0x46, 0x6c, 0x6f, 0x70, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x05, 0x63, 0x61, 0x72, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x65, 0x78, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x74, 0x65, 0x78, 0x74, 0x75, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65,
+	0x71, 0x75, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x65, 0x71, 0x75,
+	0x69, 0x74, 0x79, 0x12, 0x26, 0x0a, 0x05, 0x68, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x52, 0x05, 0x68, 0x61, 0x6e, 0x64, 0x73, 0x22, 0x98, 0x01, 0x0a, 0x0e,
+	0x54, 0x65, 0x78, 0x74, 0x75, 0x72, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x18,
+	0x0a, 0x07, 0x74, 0x65, 0x78, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x74, 0x65, 0x78, 0x74, 0x75, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x70,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x66, 0x6c, 0x6f, 0x70, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06,
+	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x71, 0x75, 0x69, 0x74, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x65, 0x71, 0x75, 0x69, 0x74, 0x79, 0x12, 0x26,
+	0x0a, 0x05, 0x68, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52,
+	0x05, 0x68, 0x61, 0x6e, 0x64, 0x73, 0x22, 0x56, 0x0a, 0x09, 0x48, 0x61, 0x6e, 0x64, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x61, 0x6e, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x61, 0x6e, 0x64, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x68, 0x65, 0x72, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04,
+	0x68, 0x65, 0x72, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x6c, 0x6c, 0x61, 0x69, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x76, 0x69, 0x6c, 0x6c, 0x61, 0x69, 0x6e, 0x22, 0x79,
+	0x0a, 0x0c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x12, 0x2a, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x12, 0x27, 0x0a, 0x0f, 0x6e, 0x75, 0x6d, 0x5f, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6e, 0x75, 0x6d, 0x53, 0x69,
+	0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xb7, 0x01, 0x0a, 0x0d, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70,
+	0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0b, 0x70, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x69, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x68, 0x69, 0x74,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x61, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x05, 0x64, 0x65, 0x61, 0x6c, 0x73, 0x12, 0x33, 0x0a, 0x0b, 0x6d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x70,
+	0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x52, 0x0a, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x55, 0x73, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e,
+	0x73, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x72, 0x64, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x72, 0x64, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0x93, 0x01, 0x0a, 0x0f, 0x53, 0x68, 0x6f, 0x77, 0x64, 0x6f, 0x77, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x75,
+	0x6e, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x43, 0x61, 0x72, 0x64, 0x73,
+	0x12, 0x2d, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x53, 0x68, 0x6f, 0x77, 0x64, 0x6f,
+	0x77, 0x6e, 0x53, 0x65, 0x61, 0x74, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12,
+	0x10, 0x0a, 0x03, 0x70, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x70, 0x6f,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x62, 0x75, 0x74, 0x74, 0x6f, 0x6e, 0x22, 0x41, 0x0a, 0x0c, 0x53, 0x68, 0x6f,
+	0x77, 0x64, 0x6f, 0x77, 0x6e, 0x53, 0x65, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x61,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x65, 0x61, 0x74, 0x12, 0x1d, 0x0a,
+	0x0a, 0x68, 0x6f, 0x6c, 0x65, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x09, 0x68, 0x6f, 0x6c, 0x65, 0x43, 0x61, 0x72, 0x64, 0x73, 0x22, 0x86, 0x01, 0x0a,
+	0x10, 0x53, 0x68, 0x6f, 0x77, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2f, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x53, 0x68, 0x6f, 0x77, 0x64,
+	0x6f, 0x77, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x12, 0x27, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x54, 0x69, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x77,
+	0x69, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x07, 0x77, 0x69,
+	0x6e, 0x6e, 0x65, 0x72, 0x73, 0x22, 0xba, 0x01, 0x0a, 0x0e, 0x53, 0x68, 0x6f, 0x77, 0x64, 0x6f,
+	0x77, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x61, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x65, 0x61, 0x74, 0x12, 0x27, 0x0a, 0x04,
+	0x68, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x6b,
+	0x65, 0x72, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
+	0x04, 0x68, 0x61, 0x6e, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70,
+	0x61, 0x79, 0x6f, 0x75, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x64, 0x64, 0x5f, 0x63, 0x68, 0x69,
+	0x70, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6f, 0x64, 0x64, 0x43, 0x68, 0x69,
+	0x70, 0x73, 0x22, 0x20, 0x0a, 0x08, 0x54, 0x69, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x65, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x05, 0x73,
+	0x65, 0x61, 0x74, 0x73, 0x22, 0x78, 0x0a, 0x09, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x74, 0x65,
+	0x6d, 0x12, 0x30, 0x0a, 0x08, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x48, 0x61, 0x6e, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x08, 0x65, 0x76, 0x61, 0x6c, 0x75,
+	0x61, 0x74, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d,
+	0x70, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x07, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0xaa,
+	0x01, 0x0a, 0x0b, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x31, 0x0a, 0x08, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x48,
+	0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x08, 0x65,
+	0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72,
+	0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x48, 0x00, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x50, 0x0a, 0x0c, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x6f, 0x6b,
+	0x65, 0x72, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x22, 0x55, 0x0a,
+	0x0d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c,
+	0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x66, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x22, 0xcb, 0x01, 0x0a, 0x1a, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x42,
+	0x6f, 0x61, 0x72, 0x64, 0x53, 0x68, 0x6f, 0x77, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x74, 0x6f, 0x70, 0x5f, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x5f, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x6f,
+	0x70, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x43, 0x61, 0x72, 0x64, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x62,
+	0x6f, 0x74, 0x74, 0x6f, 0x6d, 0x5f, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x63, 0x61, 0x72, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x62, 0x6f, 0x74, 0x74, 0x6f, 0x6d, 0x42,
+	0x6f, 0x61, 0x72, 0x64, 0x43, 0x61, 0x72, 0x64, 0x73, 0x12, 0x2d, 0x0a, 0x07, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x6b,
+	0x65, 0x72, 0x2e, 0x53, 0x68, 0x6f, 0x77, 0x64, 0x6f, 0x77, 0x6e, 0x53, 0x65, 0x61, 0x74, 0x52,
+	0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x6f, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x70, 0x6f, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75,
+	0x74, 0x74, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x62, 0x75, 0x74, 0x74,
+	0x6f, 0x6e, 0x22, 0xc3, 0x01, 0x0a, 0x1b, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x42, 0x6f, 0x61,
+	0x72, 0x64, 0x53, 0x68, 0x6f, 0x77, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x34, 0x0a, 0x09, 0x74, 0x6f, 0x70, 0x5f, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x53, 0x68,
+	0x6f, 0x77, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08,
+	0x74, 0x6f, 0x70, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x3a, 0x0a, 0x0c, 0x62, 0x6f, 0x74, 0x74,
+	0x6f, 0x6d, 0x5f, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x53, 0x68, 0x6f, 0x77, 0x64, 0x6f, 0x77, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0b, 0x62, 0x6f, 0x74, 0x74, 0x6f, 0x6d, 0x42,
+	0x6f, 0x61, 0x72, 0x64, 0x12, 0x32, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x44, 0x6f,
+	0x75, 0x62, 0x6c, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x52,
+	0x07, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x73, 0x22, 0x55, 0x0a, 0x11, 0x44, 0x6f, 0x75, 0x62,
+	0x6c, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x65, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x65, 0x61,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f,
+	0x6f, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x6f, 0x70, 0x22,
+	0xe7, 0x01, 0x0a, 0x18, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x45,
+	0x71, 0x75, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0f,
+	0x74, 0x6f, 0x70, 0x5f, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x6f, 0x70, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x43,
+	0x61, 0x72, 0x64, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x62, 0x6f, 0x74, 0x74, 0x6f, 0x6d, 0x5f, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x10, 0x62, 0x6f, 0x74, 0x74, 0x6f, 0x6d, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x43, 0x61, 0x72,
+	0x64, 0x73, 0x12, 0x2d, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x53, 0x68, 0x6f, 0x77,
+	0x64, 0x6f, 0x77, 0x6e, 0x53, 0x65, 0x61, 0x74, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x61, 0x64, 0x43, 0x61, 0x72, 0x64, 0x73,
+	0x12, 0x27, 0x0a, 0x0f, 0x6e, 0x75, 0x6d, 0x5f, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6e, 0x75, 0x6d, 0x53, 0x69,
+	0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x7a, 0x0a, 0x19, 0x44, 0x6f, 0x75,
+	0x62, 0x6c, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x45, 0x71, 0x75, 0x69, 0x74, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x65, 0x71, 0x75, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72,
+	0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x45, 0x71, 0x75, 0x69,
+	0x74, 0x79, 0x52, 0x08, 0x65, 0x71, 0x75, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f,
+	0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x72, 0x75, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x75, 0x6e, 0x22, 0xc6, 0x01, 0x0a, 0x11, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65,
+	0x42, 0x6f, 0x61, 0x72, 0x64, 0x45, 0x71, 0x75, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x65, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x65, 0x61, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x65, 0x71, 0x75, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x06, 0x65, 0x71, 0x75, 0x69, 0x74, 0x79, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x6f, 0x70, 0x5f, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x5f, 0x65, 0x71, 0x75, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0e, 0x74, 0x6f, 0x70, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x45, 0x71, 0x75, 0x69, 0x74,
+	0x79, 0x12, 0x2e, 0x0a, 0x13, 0x62, 0x6f, 0x74, 0x74, 0x6f, 0x6d, 0x5f, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x5f, 0x65, 0x71, 0x75, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11,
+	0x62, 0x6f, 0x74, 0x74, 0x6f, 0x6d, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x45, 0x71, 0x75, 0x69, 0x74,
+	0x79, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x63, 0x6f, 0x6f, 0x70, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x73, 0x63,
+	0x6f, 0x6f, 0x70, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x22, 0xc5,
+	0x01, 0x0a, 0x0e, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x6f, 0x6c, 0x65, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x68, 0x6f, 0x6c, 0x65, 0x43, 0x61, 0x72, 0x64, 0x73,
+	0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x61,
+	0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x75,
+	0x6e, 0x69, 0x74, 0x79, 0x43, 0x61, 0x72, 0x64, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x67, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a,
+	0x0f, 0x6e, 0x75, 0x6d, 0x5f, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6e, 0x75, 0x6d, 0x53, 0x69, 0x6d, 0x75, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2e, 0x0a, 0x09, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61,
+	0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x6f, 0x6b, 0x65,
+	0x72, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x09, 0x65, 0x73, 0x74,
+	0x69, 0x6d, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x64, 0x0a, 0x0f, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73,
+	0x63, 0x61, 0x72, 0x64, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x43, 0x61, 0x72, 0x64, 0x12, 0x2e, 0x0a, 0x07,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x8b, 0x02, 0x0a,
+	0x0d, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21,
+	0x0a, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x43, 0x61, 0x72,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x6b, 0x65, 0x65, 0x70, 0x43, 0x61, 0x72, 0x64, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x65, 0x71, 0x75, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x06, 0x65, 0x71, 0x75, 0x69, 0x74, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x77, 0x69, 0x6e, 0x5f,
+	0x70, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0e, 0x77, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x69, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x74, 0x69, 0x65, 0x50,
+	0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x74,
+	0x61, 0x6e, 0x64, 0x61, 0x72, 0x64, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x72, 0x64, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x27, 0x0a, 0x04, 0x68, 0x61, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x04, 0x68, 0x61, 0x6e, 0x64, 0x22, 0x3d, 0x0a, 0x0a, 0x4f, 0x46,
+	0x43, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x6f, 0x6b, 0x65,
+	0x72, 0x2e, 0x4f, 0x46, 0x43, 0x41, 0x72, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x22, 0x87, 0x01, 0x0a, 0x0e, 0x4f, 0x46,
+	0x43, 0x41, 0x72, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x65, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x65, 0x61, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x70, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x70, 0x43, 0x61, 0x72, 0x64, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x43, 0x61, 0x72, 0x64, 0x73,
+	0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6f, 0x74, 0x74, 0x6f, 0x6d, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x6f, 0x74, 0x74, 0x6f, 0x6d, 0x43, 0x61,
+	0x72, 0x64, 0x73, 0x22, 0x39, 0x0a, 0x0b, 0x4f, 0x46, 0x43, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x4f, 0x46, 0x43, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x8e,
+	0x02, 0x0a, 0x09, 0x4f, 0x46, 0x43, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x65, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x65, 0x61, 0x74,
+	0x12, 0x21, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x4f, 0x46, 0x43, 0x52, 0x6f, 0x77, 0x52, 0x04, 0x72,
+	0x6f, 0x77, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x75, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x66, 0x6f, 0x75, 0x6c, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x72,
+	0x6f, 0x79, 0x61, 0x6c, 0x74, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x72, 0x6f, 0x79, 0x61, 0x6c, 0x74, 0x69, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x61, 0x6e,
+	0x74, 0x61, 0x73, 0x79, 0x6c, 0x61, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x6c, 0x61, 0x6e, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x66,
+	0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x6c, 0x61, 0x6e, 0x64, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x66, 0x61, 0x6e, 0x74, 0x61, 0x73, 0x79, 0x6c,
+	0x61, 0x6e, 0x64, 0x43, 0x61, 0x72, 0x64, 0x73, 0x12, 0x2d, 0x0a, 0x08, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x75, 0x70, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x6f, 0x6b,
+	0x65, 0x72, 0x2e, 0x4f, 0x46, 0x43, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x75, 0x70, 0x52, 0x08, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x75, 0x70, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22,
+	0x81, 0x01, 0x0a, 0x06, 0x4f, 0x46, 0x43, 0x52, 0x6f, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x27,
+	0x0a, 0x04, 0x68, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70,
+	0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x52, 0x04, 0x68, 0x61, 0x6e, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x6f, 0x79,
+	0x61, 0x6c, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x6f, 0x79, 0x61,
+	0x6c, 0x74, 0x79, 0x22, 0x6a, 0x0a, 0x0a, 0x4f, 0x46, 0x43, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x75,
+	0x70, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x6f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x04, 0x72, 0x6f, 0x77,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x6f, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x73, 0x63, 0x6f, 0x6f, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22,
+	0x91, 0x01, 0x0a, 0x0a, 0x49, 0x43, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x01, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x01, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x73,
+	0x12, 0x28, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x10, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x49, 0x43, 0x4d, 0x4d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x6e, 0x75,
+	0x6d, 0x5f, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0e, 0x6e, 0x75, 0x6d, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0x8f, 0x01, 0x0a, 0x0b, 0x49, 0x43, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x71, 0x75, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x01, 0x52, 0x08, 0x65, 0x71, 0x75, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12,
+	0x31, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x50, 0x72, 0x6f,
+	0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x73, 0x12, 0x31, 0x0a, 0x0b, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f, 0x75, 0x73, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e,
+	0x49, 0x43, 0x4d, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x0a, 0x6d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x55, 0x73, 0x65, 0x64, 0x22, 0x3a, 0x0a, 0x12, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x50, 0x72,
+	0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x70,
+	0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x01, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x22, 0xcb, 0x02, 0x0a, 0x0f, 0x50, 0x75, 0x73, 0x68, 0x46, 0x6f, 0x6c, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x01, 0x52, 0x06, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x01, 0x52, 0x07,
+	0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x68, 0x65, 0x72, 0x6f, 0x5f,
+	0x73, 0x65, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x68, 0x65, 0x72, 0x6f,
+	0x53, 0x65, 0x61, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x76, 0x69, 0x6c, 0x6c, 0x61, 0x69, 0x6e, 0x5f,
+	0x73, 0x65, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x76, 0x69, 0x6c, 0x6c,
+	0x61, 0x69, 0x6e, 0x53, 0x65, 0x61, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x6f, 0x6c, 0x65, 0x5f,
+	0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x68, 0x6f, 0x6c,
+	0x65, 0x43, 0x61, 0x72, 0x64, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x76, 0x69, 0x6c, 0x6c, 0x61, 0x69,
+	0x6e, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x10, 0x76, 0x69, 0x6c, 0x6c, 0x61, 0x69, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6d, 0x61, 0x6c, 0x6c, 0x5f, 0x62, 0x6c,
+	0x69, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x73, 0x6d, 0x61, 0x6c, 0x6c,
+	0x42, 0x6c, 0x69, 0x6e, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x69, 0x67, 0x5f, 0x62, 0x6c, 0x69,
+	0x6e, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x62, 0x69, 0x67, 0x42, 0x6c, 0x69,
+	0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x6e, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x04, 0x61, 0x6e, 0x74, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6e, 0x75, 0x6d, 0x5f, 0x73, 0x69,
+	0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0e, 0x6e, 0x75, 0x6d, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0xfd, 0x01, 0x0a, 0x10, 0x50, 0x75, 0x73, 0x68, 0x46, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x17, 0x0a, 0x07, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x65, 0x76, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x06, 0x70, 0x75, 0x73, 0x68, 0x45, 0x76, 0x12, 0x17, 0x0a, 0x07, 0x66, 0x6f, 0x6c,
+	0x64, 0x5f, 0x65, 0x76, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x66, 0x6f, 0x6c, 0x64,
+	0x45, 0x76, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x63, 0x61,
+	0x6c, 0x6c, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x2c, 0x0a,
+	0x12, 0x65, 0x71, 0x75, 0x69, 0x74, 0x79, 0x5f, 0x77, 0x68, 0x65, 0x6e, 0x5f, 0x63, 0x61, 0x6c,
+	0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x65, 0x71, 0x75, 0x69, 0x74,
+	0x79, 0x57, 0x68, 0x65, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x70,
+	0x75, 0x73, 0x68, 0x5f, 0x63, 0x68, 0x69, 0x70, 0x5f, 0x65, 0x76, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0a, 0x70, 0x75, 0x73, 0x68, 0x43, 0x68, 0x69, 0x70, 0x45, 0x76, 0x12, 0x20, 0x0a,
+	0x0c, 0x66, 0x6f, 0x6c, 0x64, 0x5f, 0x63, 0x68, 0x69, 0x70, 0x5f, 0x65, 0x76, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0a, 0x66, 0x6f, 0x6c, 0x64, 0x43, 0x68, 0x69, 0x70, 0x45, 0x76, 0x2a,
+	0x70, 0x0a, 0x09, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x13, 0x0a, 0x0f,
+	0x45, 0x53, 0x54, 0x49, 0x4d, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x4e, 0x41, 0x49, 0x56, 0x45, 0x10,
+	0x00, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x53, 0x54, 0x49, 0x4d, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x53,
+	0x54, 0x52, 0x41, 0x54, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x45,
+	0x53, 0x54, 0x49, 0x4d, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x41, 0x4e, 0x54, 0x49, 0x54, 0x48, 0x45,
+	0x54, 0x49, 0x43, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x53, 0x54, 0x49, 0x4d, 0x41, 0x54,
+	0x4f, 0x52, 0x5f, 0x51, 0x55, 0x41, 0x53, 0x49, 0x5f, 0x52, 0x41, 0x4e, 0x44, 0x4f, 0x4d, 0x10,
+	0x03, 0x2a, 0x5c, 0x0a, 0x0e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x55, 0x4e, 0x49,
+	0x4f, 0x4e, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x49, 0x4e,
+	0x54, 0x45, 0x52, 0x53, 0x45, 0x43, 0x54, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x52, 0x41, 0x4e,
+	0x47, 0x45, 0x5f, 0x53, 0x55, 0x42, 0x54, 0x52, 0x41, 0x43, 0x54, 0x10, 0x02, 0x12, 0x10, 0x0a,
+	0x0c, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x57, 0x45, 0x49, 0x47, 0x48, 0x54, 0x10, 0x03, 0x2a,
+	0x45, 0x0a, 0x0b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x0e,
+	0x0a, 0x0a, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x41, 0x55, 0x54, 0x4f, 0x10, 0x00, 0x12, 0x0f,
+	0x0a, 0x0b, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x45, 0x58, 0x41, 0x43, 0x54, 0x10, 0x01, 0x12,
+	0x15, 0x0a, 0x11, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x4d, 0x4f, 0x4e, 0x54, 0x45, 0x5f, 0x43,
+	0x41, 0x52, 0x4c, 0x4f, 0x10, 0x02, 0x2a, 0x3d, 0x0a, 0x09, 0x49, 0x43, 0x4d, 0x4d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x43, 0x4d, 0x5f, 0x41, 0x55, 0x54, 0x4f, 0x10,
+	0x00, 0x12, 0x0d, 0x0a, 0x09, 0x49, 0x43, 0x4d, 0x5f, 0x45, 0x58, 0x41, 0x43, 0x54, 0x10, 0x01,
+	0x12, 0x13, 0x0a, 0x0f, 0x49, 0x43, 0x4d, 0x5f, 0x4d, 0x4f, 0x4e, 0x54, 0x45, 0x5f, 0x43, 0x41,
+	0x52, 0x4c, 0x4f, 0x10, 0x02, 0x32, 0xd8, 0x0a, 0x0a, 0x0c, 0x50, 0x6f, 0x6b, 0x65, 0x72, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x37, 0x0a, 0x0c, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61,
+	0x74, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x12, 0x12, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x48,
+	0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x6f, 0x6b,
+	0x65, 0x72, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3d, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x73, 0x12,
+	0x15, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d,
+	0x0a, 0x14, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x62, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x11, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x53,
+	0x69, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70, 0x6f, 0x6b, 0x65,
+	0x72, 0x2e, 0x53, 0x69, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a,
+	0x15, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x53, 0x74,
+	0x72, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x1a, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x48,
+	0x61, 0x6e, 0x64, 0x53, 0x74, 0x72, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x53,
+	0x74, 0x72, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x40, 0x0a, 0x0c, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x12,
+	0x13, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x42, 0x6f, 0x61,
+	0x72, 0x64, 0x54, 0x65, 0x78, 0x74, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x36, 0x0a, 0x0b, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x4e, 0x75, 0x74, 0x73,
+	0x12, 0x12, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x4e, 0x75, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x4e, 0x75, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0f, 0x41, 0x6e, 0x61,
+	0x6c, 0x79, 0x7a, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x15, 0x2e, 0x70,
+	0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0d, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x13, 0x2e, 0x70,
+	0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x14, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x62, 0x69,
+	0x6e, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72,
+	0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x16,
+	0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x45, 0x71, 0x75, 0x69, 0x74, 0x79, 0x48,
+	0x65, 0x61, 0x74, 0x6d, 0x61, 0x70, 0x12, 0x15, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x48,
+	0x65, 0x61, 0x74, 0x6d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x48, 0x65, 0x61, 0x74, 0x6d, 0x61, 0x70, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x17, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61,
+	0x74, 0x65, 0x45, 0x71, 0x75, 0x69, 0x74, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x12, 0x16, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x47, 0x0a, 0x0c, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x46, 0x6c, 0x6f, 0x70,
+	0x73, 0x12, 0x1a, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x46, 0x6c, 0x6f, 0x70, 0x41, 0x6e,
+	0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e,
+	0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x46, 0x6c, 0x6f, 0x70, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73,
+	0x69, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x30, 0x01, 0x12, 0x3a, 0x0a, 0x0d, 0x45, 0x76,
+	0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x13, 0x2e, 0x70, 0x6f,
+	0x6b, 0x65, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x14, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x53, 0x68, 0x6f, 0x77, 0x64, 0x6f,
+	0x77, 0x6e, 0x12, 0x16, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x53, 0x68, 0x6f, 0x77, 0x64,
+	0x6f, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x6f, 0x6b,
+	0x65, 0x72, 0x2e, 0x53, 0x68, 0x6f, 0x77, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0d, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x12, 0x13, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x6f, 0x6b, 0x65,
+	0x72, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3a, 0x0a, 0x0e, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x12, 0x10, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49,
+	0x74, 0x65, 0x6d, 0x1a, 0x12, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x28, 0x01, 0x30, 0x01, 0x12, 0x5c, 0x0a, 0x13, 0x44,
+	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x53, 0x68, 0x6f, 0x77, 0x64, 0x6f,
+	0x77, 0x6e, 0x12, 0x21, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c,
+	0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x53, 0x68, 0x6f, 0x77, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x44, 0x6f,
+	0x75, 0x62, 0x6c, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x53, 0x68, 0x6f, 0x77, 0x64, 0x6f, 0x77,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x1a, 0x43, 0x61, 0x6c,
+	0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x42, 0x6f, 0x61, 0x72,
+	0x64, 0x45, 0x71, 0x75, 0x69, 0x74, 0x79, 0x12, 0x1f, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e,
+	0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x45, 0x71, 0x75, 0x69, 0x74,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72,
+	0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x45, 0x71, 0x75, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x10, 0x52, 0x65,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x12, 0x15,
+	0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x44, 0x69,
+	0x73, 0x63, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a,
+	0x08, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x4f, 0x46, 0x43, 0x12, 0x11, 0x2e, 0x70, 0x6f, 0x6b, 0x65,
+	0x72, 0x2e, 0x4f, 0x46, 0x43, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70,
+	0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x4f, 0x46, 0x43, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x32, 0x88, 0x01, 0x0a, 0x0a, 0x49, 0x43, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x35, 0x0a, 0x0c, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x43, 0x4d, 0x12,
+	0x11, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x49, 0x43, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x49, 0x43, 0x4d, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x10, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61,
+	0x74, 0x65, 0x50, 0x75, 0x73, 0x68, 0x46, 0x6f, 0x6c, 0x64, 0x12, 0x16, 0x2e, 0x70, 0x6f, 0x6b,
+	0x65, 0x72, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x46, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x46,
+	0x6f, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x09, 0x5a, 0x07, 0x2e,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_poker_proto_rawDescOnce sync.Once
+	file_proto_poker_proto_rawDescData = file_proto_poker_proto_rawDesc
+)
+
+func file_proto_poker_proto_rawDescGZIP() []byte {
+	file_proto_poker_proto_rawDescOnce.Do(func() {
+		file_proto_poker_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_poker_proto_rawDescData)
+	})
+	return file_proto_poker_proto_rawDescData
+}
+
+var file_proto_poker_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_proto_poker_proto_msgTypes = make([]protoimpl.MessageInfo, 70)
+var file_proto_poker_proto_goTypes = []interface{}{
+	(Estimator)(0),                      // 0: poker.Estimator
+	(RangeOperation)(0),                 // 1: poker.RangeOperation
+	(QueryMethod)(0),                    // 2: poker.QueryMethod
+	(ICMMethod)(0),                      // 3: poker.ICMMethod
+	(*HandRequest)(nil),                 // 4: poker.HandRequest
+	(*WildCards)(nil),                   // 5: poker.WildCards
+	(*Substitution)(nil),                // 6: poker.Substitution
+	(*HandResponse)(nil),                // 7: poker.HandResponse
+	(*Draw)(nil),                        // 8: poker.Draw
+	(*CompareRequest)(nil),              // 9: poker.CompareRequest
+	(*CompareResponse)(nil),             // 10: poker.CompareResponse
+	(*TieBreak)(nil),                    // 11: poker.TieBreak
+	(*SimRequest)(nil),                  // 12: poker.SimRequest
+	(*SimResponse)(nil),                 // 13: poker.SimResponse
+	(*HandStrengthRequest)(nil),         // 14: poker.HandStrengthRequest
+	(*HandStrengthResponse)(nil),        // 15: poker.HandStrengthResponse
+	(*BoardRequest)(nil),                // 16: poker.BoardRequest
+	(*BoardTextureResponse)(nil),        // 17: poker.BoardTextureResponse
+	(*HandCount)(nil),                   // 18: poker.HandCount
+	(*NutsRequest)(nil),                 // 19: poker.NutsRequest
+	(*NutsResponse)(nil),                // 20: poker.NutsResponse
+	(*NutClass)(nil),                    // 21: poker.NutClass
+	(*Holding)(nil),                     // 22: poker.Holding
+	(*BlockerRequest)(nil),              // 23: poker.BlockerRequest
+	(*BlockerResponse)(nil),             // 24: poker.BlockerResponse
+	(*BlockerGroup)(nil),                // 25: poker.BlockerGroup
+	(*RangeRequest)(nil),                // 26: poker.RangeRequest
+	(*RangeOperationRequest)(nil),       // 27: poker.RangeOperationRequest
+	(*RangeResponse)(nil),               // 28: poker.RangeResponse
+	(*RangeGridRow)(nil),                // 29: poker.RangeGridRow
+	(*RangeGridCell)(nil),               // 30: poker.RangeGridCell
+	(*HeatmapRequest)(nil),              // 31: poker.HeatmapRequest
+	(*HeatmapResponse)(nil),             // 32: poker.HeatmapResponse
+	(*HeatmapRow)(nil),                  // 33: poker.HeatmapRow
+	(*HeatmapCell)(nil),                 // 34: poker.HeatmapCell
+	(*TimelineRequest)(nil),             // 35: poker.TimelineRequest
+	(*TimelineResponse)(nil),            // 36: poker.TimelineResponse
+	(*StreetEquity)(nil),                // 37: poker.StreetEquity
+	(*FlopAnalysisRequest)(nil),         // 38: poker.FlopAnalysisRequest
+	(*FlopAnalysisUpdate)(nil),          // 39: poker.FlopAnalysisUpdate
+	(*FlopResult)(nil),                  // 40: poker.FlopResult
+	(*TextureSummary)(nil),              // 41: poker.TextureSummary
+	(*HandShare)(nil),                   // 42: poker.HandShare
+	(*QueryRequest)(nil),                // 43: poker.QueryRequest
+	(*QueryResponse)(nil),               // 44: poker.QueryResponse
+	(*ShowdownRequest)(nil),             // 45: poker.ShowdownRequest
+	(*ShowdownSeat)(nil),                // 46: poker.ShowdownSeat
+	(*ShowdownResponse)(nil),            // 47: poker.ShowdownResponse
+	(*ShowdownResult)(nil),              // 48: poker.ShowdownResult
+	(*TieGroup)(nil),                    // 49: poker.TieGroup
+	(*BatchItem)(nil),                   // 50: poker.BatchItem
+	(*BatchResult)(nil),                 // 51: poker.BatchResult
+	(*BatchRequest)(nil),                // 52: poker.BatchRequest
+	(*BatchResponse)(nil),               // 53: poker.BatchResponse
+	(*DoubleBoardShowdownRequest)(nil),  // 54: poker.DoubleBoardShowdownRequest
+	(*DoubleBoardShowdownResponse)(nil), // 55: poker.DoubleBoardShowdownResponse
+	(*DoubleBoardPayout)(nil),           // 56: poker.DoubleBoardPayout
+	(*DoubleBoardEquityRequest)(nil),    // 57: poker.DoubleBoardEquityRequest
+	(*DoubleBoardEquityResponse)(nil),   // 58: poker.DoubleBoardEquityResponse
+	(*DoubleBoardEquity)(nil),           // 59: poker.DoubleBoardEquity
+	(*DiscardRequest)(nil),              // 60: poker.DiscardRequest
+	(*DiscardResponse)(nil),             // 61: poker.DiscardResponse
+	(*DiscardOption)(nil),               // 62: poker.DiscardOption
+	(*OFCRequest)(nil),                  // 63: poker.OFCRequest
+	(*OFCArrangement)(nil),              // 64: poker.OFCArrangement
+	(*OFCResponse)(nil),                 // 65: poker.OFCResponse
+	(*OFCResult)(nil),                   // 66: poker.OFCResult
+	(*OFCRow)(nil),                      // 67: poker.OFCRow
+	(*OFCMatchup)(nil),                  // 68: poker.OFCMatchup
+	(*ICMRequest)(nil),                  // 69: poker.ICMRequest
+	(*ICMResponse)(nil),                 // 70: poker.ICMResponse
+	(*PlaceProbabilities)(nil),          // 71: poker.PlaceProbabilities
+	(*PushFoldRequest)(nil),             // 72: poker.PushFoldRequest
+	(*PushFoldResponse)(nil),            // 73: poker.PushFoldResponse
+}
+var file_proto_poker_proto_depIdxs = []int32{
+	5,  // 0: poker.HandRequest.wild:type_name -> poker.WildCards
+	8,  // 1: poker.HandResponse.draws:type_name -> poker.Draw
+	6,  // 2: poker.HandResponse.substitutions:type_name -> poker.Substitution
+	4,  // 3: poker.CompareRequest.hand1:type_name -> poker.HandRequest
+	4,  // 4: poker.CompareRequest.hand2:type_name -> poker.HandRequest
+	7,  // 5: poker.CompareResponse.hand1_result:type_name -> poker.HandResponse
+	7,  // 6: poker.CompareResponse.hand2_result:type_name -> poker.HandResponse
+	11, // 7: poker.CompareResponse.tie_break:type_name -> poker.TieBreak
+	0,  // 8: poker.SimRequest.estimator:type_name -> poker.Estimator
+	5,  // 9: poker.SimRequest.wild:type_name -> poker.WildCards
+	0,  // 10: poker.SimResponse.estimator_used:type_name -> poker.Estimator
+	18, // 11: poker.BoardTextureResponse.possible_hands:type_name -> poker.HandCount
+	18, // 12: poker.BoardTextureResponse.possible_draws:type_name -> poker.HandCount
+	21, // 13: poker.NutsResponse.nut_classes:type_name -> poker.NutClass
+	22, // 14: poker.NutsResponse.beats_hero:type_name -> poker.Holding
+	22, // 15: poker.NutsResponse.ties_hero:type_name -> poker.Holding
+	22, // 16: poker.NutsResponse.loses_to_hero:type_name -> poker.Holding
+	22, // 17: poker.NutClass.holdings:type_name -> poker.Holding
+	25, // 18: poker.BlockerResponse.groups:type_name -> poker.BlockerGroup
+	1,  // 19: poker.RangeOperationRequest.operation:type_name -> poker.RangeOperation
+	29, // 20: poker.RangeResponse.grid:type_name -> poker.RangeGridRow
+	30, // 21: poker.RangeGridRow.cells:type_name -> poker.RangeGridCell
+	33, // 22: poker.HeatmapResponse.rows:type_name -> poker.HeatmapRow
+	34, // 23: poker.HeatmapRow.cells:type_name -> poker.HeatmapCell
+	22, // 24: poker.TimelineRequest.players:type_name -> poker.Holding
+	37, // 25: poker.TimelineResponse.streets:type_name -> poker.StreetEquity
+	40, // 26: poker.FlopAnalysisUpdate.flop:type_name -> poker.FlopResult
+	41, // 27: poker.FlopAnalysisUpdate.textures:type_name -> poker.TextureSummary
+	41, // 28: poker.FlopAnalysisUpdate.overall:type_name -> poker.TextureSummary
+	42, // 29: poker.FlopResult.hands:type_name -> poker.HandShare
+	42, // 30: poker.TextureSummary.hands:type_name -> poker.HandShare
+	2,  // 31: poker.QueryRequest.method:type_name -> poker.QueryMethod
+	2,  // 32: poker.QueryResponse.method_used:type_name -> poker.QueryMethod
+	46, // 33: poker.ShowdownRequest.players:type_name -> poker.ShowdownSeat
+	48, // 34: poker.ShowdownResponse.results:type_name -> poker.ShowdownResult
+	49, // 35: poker.ShowdownResponse.places:type_name -> poker.TieGroup
+	7,  // 36: poker.ShowdownResult.hand:type_name -> poker.HandResponse
+	4,  // 37: poker.BatchItem.evaluate:type_name -> poker.HandRequest
+	9,  // 38: poker.BatchItem.compare:type_name -> poker.CompareRequest
+	7,  // 39: poker.BatchResult.evaluate:type_name -> poker.HandResponse
+	10, // 40: poker.BatchResult.compare:type_name -> poker.CompareResponse
+	50, // 41: poker.BatchRequest.items:type_name -> poker.BatchItem
+	51, // 42: poker.BatchResponse.results:type_name -> poker.BatchResult
+	46, // 43: poker.DoubleBoardShowdownRequest.players:type_name -> poker.ShowdownSeat
+	47, // 44: poker.DoubleBoardShowdownResponse.top_board:type_name -> poker.ShowdownResponse
+	47, // 45: poker.DoubleBoardShowdownResponse.bottom_board:type_name -> poker.ShowdownResponse
+	56, // 46: poker.DoubleBoardShowdownResponse.payouts:type_name -> poker.DoubleBoardPayout
+	46, // 47: poker.DoubleBoardEquityRequest.players:type_name -> poker.ShowdownSeat
+	59, // 48: poker.DoubleBoardEquityResponse.equities:type_name -> poker.DoubleBoardEquity
+	0,  // 49: poker.DiscardRequest.estimator:type_name -> poker.Estimator
+	62, // 50: poker.DiscardResponse.options:type_name -> poker.DiscardOption
+	7,  // 51: poker.DiscardOption.hand:type_name -> poker.HandResponse
+	64, // 52: poker.OFCRequest.players:type_name -> poker.OFCArrangement
+	66, // 53: poker.OFCResponse.results:type_name -> poker.OFCResult
+	67, // 54: poker.OFCResult.rows:type_name -> poker.OFCRow
+	68, // 55: poker.OFCResult.matchups:type_name -> poker.OFCMatchup
+	7,  // 56: poker.OFCRow.hand:type_name -> poker.HandResponse
+	3,  // 57: poker.ICMRequest.method:type_name -> poker.ICMMethod
+	71, // 58: poker.ICMResponse.places:type_name -> poker.PlaceProbabilities
+	3,  // 59: poker.ICMResponse.method_used:type_name -> poker.ICMMethod
+	4,  // 60: poker.PokerService.EvaluateHand:input_type -> poker.HandRequest
+	9,  // 61: poker.PokerService.CompareHands:input_type -> poker.CompareRequest
+	12, // 62: poker.PokerService.CalculateProbability:input_type -> poker.SimRequest
+	14, // 63: poker.PokerService.CalculateHandStrength:input_type -> poker.HandStrengthRequest
+	16, // 64: poker.PokerService.AnalyzeBoard:input_type -> poker.BoardRequest
+	19, // 65: poker.PokerService.AnalyzeNuts:input_type -> poker.NutsRequest
+	23, // 66: poker.PokerService.AnalyzeBlockers:input_type -> poker.BlockerRequest
+	26, // 67: poker.PokerService.DescribeRange:input_type -> poker.RangeRequest
+	27, // 68: poker.PokerService.CombineRanges:input_type -> poker.RangeOperationRequest
+	31, // 69: poker.PokerService.CalculateEquityHeatmap:input_type -> poker.HeatmapRequest
+	35, // 70: poker.PokerService.CalculateEquityTimeline:input_type -> poker.TimelineRequest
+	38, // 71: poker.PokerService.AnalyzeFlops:input_type -> poker.FlopAnalysisRequest
+	43, // 72: poker.PokerService.EvaluateQuery:input_type -> poker.QueryRequest
+	45, // 73: poker.PokerService.Showdown:input_type -> poker.ShowdownRequest
+	52, // 74: poker.PokerService.EvaluateBatch:input_type -> poker.BatchRequest
+	50, // 75: poker.PokerService.EvaluateStream:input_type -> poker.BatchItem
+	54, // 76: poker.PokerService.DoubleBoardShowdown:input_type -> poker.DoubleBoardShowdownRequest
+	57, // 77: poker.PokerService.CalculateDoubleBoardEquity:input_type -> poker.DoubleBoardEquityRequest
+	60, // 78: poker.PokerService.RecommendDiscard:input_type -> poker.DiscardRequest
+	63, // 79: poker.PokerService.ScoreOFC:input_type -> poker.OFCRequest
+	69, // 80: poker.ICMService.CalculateICM:input_type -> poker.ICMRequest
+	72, // 81: poker.ICMService.EvaluatePushFold:input_type -> poker.PushFoldRequest
+	7,  // 82: poker.PokerService.EvaluateHand:output_type -> poker.HandResponse
+	10, // 83: poker.PokerService.CompareHands:output_type -> poker.CompareResponse
+	13, // 84: poker.PokerService.CalculateProbability:output_type -> poker.SimResponse
+	15, // 85: poker.PokerService.CalculateHandStrength:output_type -> poker.HandStrengthResponse
+	17, // 86: poker.PokerService.AnalyzeBoard:output_type -> poker.BoardTextureResponse
+	20, // 87: poker.PokerService.AnalyzeNuts:output_type -> poker.NutsResponse
+	24, // 88: poker.PokerService.AnalyzeBlockers:output_type -> poker.BlockerResponse
+	28, // 89: poker.PokerService.DescribeRange:output_type -> poker.RangeResponse
+	28, // 90: poker.PokerService.CombineRanges:output_type -> poker.RangeResponse
+	32, // 91: poker.PokerService.CalculateEquityHeatmap:output_type -> poker.HeatmapResponse
+	36, // 92: poker.PokerService.CalculateEquityTimeline:output_type -> poker.TimelineResponse
+	39, // 93: poker.PokerService.AnalyzeFlops:output_type -> poker.FlopAnalysisUpdate
+	44, // 94: poker.PokerService.EvaluateQuery:output_type -> poker.QueryResponse
+	47, // 95: poker.PokerService.Showdown:output_type -> poker.ShowdownResponse
+	53, // 96: poker.PokerService.EvaluateBatch:output_type -> poker.BatchResponse
+	51, // 97: poker.PokerService.EvaluateStream:output_type -> poker.BatchResult
+	55, // 98: poker.PokerService.DoubleBoardShowdown:output_type -> poker.DoubleBoardShowdownResponse
+	58, // 99: poker.PokerService.CalculateDoubleBoardEquity:output_type -> poker.DoubleBoardEquityResponse
+	61, // 100: poker.PokerService.RecommendDiscard:output_type -> poker.DiscardResponse
+	65, // 101: poker.PokerService.ScoreOFC:output_type -> poker.OFCResponse
+	70, // 102: poker.ICMService.CalculateICM:output_type -> poker.ICMResponse
+	73, // 103: poker.ICMService.EvaluatePushFold:output_type -> poker.PushFoldResponse
+	82, // [82:104] is the sub-list for method output_type
+	60, // [60:82] is the sub-list for method input_type
+	60, // [60:60] is the sub-list for extension type_name
+	60, // [60:60] is the sub-list for extension extendee
+	0,  // [0:60] is the sub-list for field type_name
+}
+
+func init() { file_proto_poker_proto_init() }
+func file_proto_poker_proto_init() {
+	if File_proto_poker_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_poker_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HandRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WildCards); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Substitution); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HandResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Draw); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompareRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompareResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TieBreak); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HandStrengthRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HandStrengthResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoardTextureResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HandCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NutsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NutsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NutClass); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Holding); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockerGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RangeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RangeOperationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RangeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RangeGridRow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RangeGridCell); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeatmapRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeatmapResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeatmapRow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeatmapCell); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimelineRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimelineResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreetEquity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlopAnalysisRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlopAnalysisUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlopResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TextureSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HandShare); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -516,8 +6749,8 @@ func file_proto_poker_proto_init() {
 				return nil
 			}
 		}
-		file_proto_poker_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HandResponse); i {
+		file_proto_poker_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShowdownRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -528,8 +6761,8 @@ func file_proto_poker_proto_init() {
 				return nil
 			}
 		}
-		file_proto_poker_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CompareRequest); i {
+		file_proto_poker_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShowdownSeat); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -540,8 +6773,8 @@ func file_proto_poker_proto_init() {
 				return nil
 			}
 		}
-		file_proto_poker_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CompareResponse); i {
+		file_proto_poker_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShowdownResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -552,8 +6785,8 @@ func file_proto_poker_proto_init() {
 				return nil
 			}
 		}
-		file_proto_poker_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SimRequest); i {
+		file_proto_poker_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShowdownResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -564,8 +6797,260 @@ func file_proto_poker_proto_init() {
 				return nil
 			}
 		}
-		file_proto_poker_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SimResponse); i {
+		file_proto_poker_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TieGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoubleBoardShowdownRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoubleBoardShowdownResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoubleBoardPayout); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoubleBoardEquityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoubleBoardEquityResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DoubleBoardEquity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiscardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiscardResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiscardOption); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OFCRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OFCArrangement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OFCResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OFCResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OFCRow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OFCMatchup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ICMRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ICMResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -576,19 +7061,64 @@ func file_proto_poker_proto_init() {
 				return nil
 			}
 		}
+		file_proto_poker_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlaceProbabilities); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushFoldRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_poker_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushFoldResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_proto_poker_proto_msgTypes[46].OneofWrappers = []interface{}{
+		(*BatchItem_Evaluate)(nil),
+		(*BatchItem_Compare)(nil),
+	}
+	file_proto_poker_proto_msgTypes[47].OneofWrappers = []interface{}{
+		(*BatchResult_Evaluate)(nil),
+		(*BatchResult_Compare)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_poker_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   6,
+			NumEnums:      4,
+			NumMessages:   70,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_proto_poker_proto_goTypes,
 		DependencyIndexes: file_proto_poker_proto_depIdxs,
+		EnumInfos:         file_proto_poker_proto_enumTypes,
 		MessageInfos:      file_proto_poker_proto_msgTypes,
 	}.Build()
 	File_proto_poker_proto = out.File
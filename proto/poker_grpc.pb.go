@@ -28,6 +28,43 @@ type PokerServiceClient interface {
 	CompareHands(ctx context.Context, in *CompareRequest, opts ...grpc.CallOption) (*CompareResponse, error)
 	// Task: Monte Carlo probability
 	CalculateProbability(ctx context.Context, in *SimRequest, opts ...grpc.CallOption) (*SimResponse, error)
+	// Task: Hand strength and hand potential (EHS) on the flop or turn
+	CalculateHandStrength(ctx context.Context, in *HandStrengthRequest, opts ...grpc.CallOption) (*HandStrengthResponse, error)
+	// Task: Board texture classification
+	AnalyzeBoard(ctx context.Context, in *BoardRequest, opts ...grpc.CallOption) (*BoardTextureResponse, error)
+	// Task: Best possible holdings on a board, and what beats hero
+	AnalyzeNuts(ctx context.Context, in *NutsRequest, opts ...grpc.CallOption) (*NutsResponse, error)
+	// Task: How hero's cards remove combos from an opponent range
+	AnalyzeBlockers(ctx context.Context, in *BlockerRequest, opts ...grpc.CallOption) (*BlockerResponse, error)
+	// Task: Normalize a range to compact notation and a 13x13 grid
+	DescribeRange(ctx context.Context, in *RangeRequest, opts ...grpc.CallOption) (*RangeResponse, error)
+	// Task: Union, intersection, subtraction and weighting of ranges
+	CombineRanges(ctx context.Context, in *RangeOperationRequest, opts ...grpc.CallOption) (*RangeResponse, error)
+	// Task: Hero equity for all 169 starting hand classes as a 13x13 heatmap
+	CalculateEquityHeatmap(ctx context.Context, in *HeatmapRequest, opts ...grpc.CallOption) (*HeatmapResponse, error)
+	// Task: Each player's equity on every street of a showdown, and where the lead changed
+	CalculateEquityTimeline(ctx context.Context, in *TimelineRequest, opts ...grpc.CallOption) (*TimelineResponse, error)
+	// Task: Hero range against villain range over every distinct flop, streamed as flops finish.
+	// Flops equal up to suit renaming are analyzed once, which assumes both ranges treat every
+	// suit alike. When a range names suits (e.g. "HAHK") each flop is expanded into the real
+	// flops it stands for, so flops_total grows up to 22100.
+	AnalyzeFlops(ctx context.Context, in *FlopAnalysisRequest, opts ...grpc.CallOption) (PokerService_AnalyzeFlopsClient, error)
+	// Task: Answer a probability question, e.g. "P(flush or better by river | hole=AhKh)"
+	EvaluateQuery(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResponse, error)
+	// Task: Rank N players on one board and split the pot, odd chips by seat
+	Showdown(ctx context.Context, in *ShowdownRequest, opts ...grpc.CallOption) (*ShowdownResponse, error)
+	// Task: Many evaluations and comparisons in one call, run on a worker pool
+	EvaluateBatch(ctx context.Context, in *BatchRequest, opts ...grpc.CallOption) (*BatchResponse, error)
+	// Task: Evaluations and comparisons streamed both ways, results in request order
+	EvaluateStream(ctx context.Context, opts ...grpc.CallOption) (PokerService_EvaluateStreamClient, error)
+	// Task: Showdown on two boards, half the pot to the best hand on each
+	DoubleBoardShowdown(ctx context.Context, in *DoubleBoardShowdownRequest, opts ...grpc.CallOption) (*DoubleBoardShowdownResponse, error)
+	// Task: Pot equity with both boards run out together
+	CalculateDoubleBoardEquity(ctx context.Context, in *DoubleBoardEquityRequest, opts ...grpc.CallOption) (*DoubleBoardEquityResponse, error)
+	// Task: Pineapple discard advice from the equity of each two-card keep
+	RecommendDiscard(ctx context.Context, in *DiscardRequest, opts ...grpc.CallOption) (*DiscardResponse, error)
+	// Task: Open-Face Chinese Poker scoring with fouls, royalties and Fantasyland
+	ScoreOFC(ctx context.Context, in *OFCRequest, opts ...grpc.CallOption) (*OFCResponse, error)
 }
 
 type pokerServiceClient struct {
@@ -65,6 +102,204 @@ func (c *pokerServiceClient) CalculateProbability(ctx context.Context, in *SimRe
 	return out, nil
 }
 
+func (c *pokerServiceClient) CalculateHandStrength(ctx context.Context, in *HandStrengthRequest, opts ...grpc.CallOption) (*HandStrengthResponse, error) {
+	out := new(HandStrengthResponse)
+	err := c.cc.Invoke(ctx, "/poker.PokerService/CalculateHandStrength", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokerServiceClient) AnalyzeBoard(ctx context.Context, in *BoardRequest, opts ...grpc.CallOption) (*BoardTextureResponse, error) {
+	out := new(BoardTextureResponse)
+	err := c.cc.Invoke(ctx, "/poker.PokerService/AnalyzeBoard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokerServiceClient) AnalyzeNuts(ctx context.Context, in *NutsRequest, opts ...grpc.CallOption) (*NutsResponse, error) {
+	out := new(NutsResponse)
+	err := c.cc.Invoke(ctx, "/poker.PokerService/AnalyzeNuts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokerServiceClient) AnalyzeBlockers(ctx context.Context, in *BlockerRequest, opts ...grpc.CallOption) (*BlockerResponse, error) {
+	out := new(BlockerResponse)
+	err := c.cc.Invoke(ctx, "/poker.PokerService/AnalyzeBlockers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokerServiceClient) DescribeRange(ctx context.Context, in *RangeRequest, opts ...grpc.CallOption) (*RangeResponse, error) {
+	out := new(RangeResponse)
+	err := c.cc.Invoke(ctx, "/poker.PokerService/DescribeRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokerServiceClient) CombineRanges(ctx context.Context, in *RangeOperationRequest, opts ...grpc.CallOption) (*RangeResponse, error) {
+	out := new(RangeResponse)
+	err := c.cc.Invoke(ctx, "/poker.PokerService/CombineRanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokerServiceClient) CalculateEquityHeatmap(ctx context.Context, in *HeatmapRequest, opts ...grpc.CallOption) (*HeatmapResponse, error) {
+	out := new(HeatmapResponse)
+	err := c.cc.Invoke(ctx, "/poker.PokerService/CalculateEquityHeatmap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokerServiceClient) CalculateEquityTimeline(ctx context.Context, in *TimelineRequest, opts ...grpc.CallOption) (*TimelineResponse, error) {
+	out := new(TimelineResponse)
+	err := c.cc.Invoke(ctx, "/poker.PokerService/CalculateEquityTimeline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokerServiceClient) AnalyzeFlops(ctx context.Context, in *FlopAnalysisRequest, opts ...grpc.CallOption) (PokerService_AnalyzeFlopsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &PokerService_ServiceDesc.Streams[0], "/poker.PokerService/AnalyzeFlops", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pokerServiceAnalyzeFlopsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PokerService_AnalyzeFlopsClient interface {
+	Recv() (*FlopAnalysisUpdate, error)
+	grpc.ClientStream
+}
+
+type pokerServiceAnalyzeFlopsClient struct {
+	grpc.ClientStream
+}
+
+func (x *pokerServiceAnalyzeFlopsClient) Recv() (*FlopAnalysisUpdate, error) {
+	m := new(FlopAnalysisUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *pokerServiceClient) EvaluateQuery(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResponse, error) {
+	out := new(QueryResponse)
+	err := c.cc.Invoke(ctx, "/poker.PokerService/EvaluateQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokerServiceClient) Showdown(ctx context.Context, in *ShowdownRequest, opts ...grpc.CallOption) (*ShowdownResponse, error) {
+	out := new(ShowdownResponse)
+	err := c.cc.Invoke(ctx, "/poker.PokerService/Showdown", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokerServiceClient) EvaluateBatch(ctx context.Context, in *BatchRequest, opts ...grpc.CallOption) (*BatchResponse, error) {
+	out := new(BatchResponse)
+	err := c.cc.Invoke(ctx, "/poker.PokerService/EvaluateBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokerServiceClient) EvaluateStream(ctx context.Context, opts ...grpc.CallOption) (PokerService_EvaluateStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &PokerService_ServiceDesc.Streams[1], "/poker.PokerService/EvaluateStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pokerServiceEvaluateStreamClient{stream}
+	return x, nil
+}
+
+type PokerService_EvaluateStreamClient interface {
+	Send(*BatchItem) error
+	Recv() (*BatchResult, error)
+	grpc.ClientStream
+}
+
+type pokerServiceEvaluateStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *pokerServiceEvaluateStreamClient) Send(m *BatchItem) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *pokerServiceEvaluateStreamClient) Recv() (*BatchResult, error) {
+	m := new(BatchResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *pokerServiceClient) DoubleBoardShowdown(ctx context.Context, in *DoubleBoardShowdownRequest, opts ...grpc.CallOption) (*DoubleBoardShowdownResponse, error) {
+	out := new(DoubleBoardShowdownResponse)
+	err := c.cc.Invoke(ctx, "/poker.PokerService/DoubleBoardShowdown", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokerServiceClient) CalculateDoubleBoardEquity(ctx context.Context, in *DoubleBoardEquityRequest, opts ...grpc.CallOption) (*DoubleBoardEquityResponse, error) {
+	out := new(DoubleBoardEquityResponse)
+	err := c.cc.Invoke(ctx, "/poker.PokerService/CalculateDoubleBoardEquity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokerServiceClient) RecommendDiscard(ctx context.Context, in *DiscardRequest, opts ...grpc.CallOption) (*DiscardResponse, error) {
+	out := new(DiscardResponse)
+	err := c.cc.Invoke(ctx, "/poker.PokerService/RecommendDiscard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pokerServiceClient) ScoreOFC(ctx context.Context, in *OFCRequest, opts ...grpc.CallOption) (*OFCResponse, error) {
+	out := new(OFCResponse)
+	err := c.cc.Invoke(ctx, "/poker.PokerService/ScoreOFC", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PokerServiceServer is the server API for PokerService service.
 // All implementations must embed UnimplementedPokerServiceServer
 // for forward compatibility
@@ -75,6 +310,43 @@ type PokerServiceServer interface {
 	CompareHands(context.Context, *CompareRequest) (*CompareResponse, error)
 	// Task: Monte Carlo probability
 	CalculateProbability(context.Context, *SimRequest) (*SimResponse, error)
+	// Task: Hand strength and hand potential (EHS) on the flop or turn
+	CalculateHandStrength(context.Context, *HandStrengthRequest) (*HandStrengthResponse, error)
+	// Task: Board texture classification
+	AnalyzeBoard(context.Context, *BoardRequest) (*BoardTextureResponse, error)
+	// Task: Best possible holdings on a board, and what beats hero
+	AnalyzeNuts(context.Context, *NutsRequest) (*NutsResponse, error)
+	// Task: How hero's cards remove combos from an opponent range
+	AnalyzeBlockers(context.Context, *BlockerRequest) (*BlockerResponse, error)
+	// Task: Normalize a range to compact notation and a 13x13 grid
+	DescribeRange(context.Context, *RangeRequest) (*RangeResponse, error)
+	// Task: Union, intersection, subtraction and weighting of ranges
+	CombineRanges(context.Context, *RangeOperationRequest) (*RangeResponse, error)
+	// Task: Hero equity for all 169 starting hand classes as a 13x13 heatmap
+	CalculateEquityHeatmap(context.Context, *HeatmapRequest) (*HeatmapResponse, error)
+	// Task: Each player's equity on every street of a showdown, and where the lead changed
+	CalculateEquityTimeline(context.Context, *TimelineRequest) (*TimelineResponse, error)
+	// Task: Hero range against villain range over every distinct flop, streamed as flops finish.
+	// Flops equal up to suit renaming are analyzed once, which assumes both ranges treat every
+	// suit alike. When a range names suits (e.g. "HAHK") each flop is expanded into the real
+	// flops it stands for, so flops_total grows up to 22100.
+	AnalyzeFlops(*FlopAnalysisRequest, PokerService_AnalyzeFlopsServer) error
+	// Task: Answer a probability question, e.g. "P(flush or better by river | hole=AhKh)"
+	EvaluateQuery(context.Context, *QueryRequest) (*QueryResponse, error)
+	// Task: Rank N players on one board and split the pot, odd chips by seat
+	Showdown(context.Context, *ShowdownRequest) (*ShowdownResponse, error)
+	// Task: Many evaluations and comparisons in one call, run on a worker pool
+	EvaluateBatch(context.Context, *BatchRequest) (*BatchResponse, error)
+	// Task: Evaluations and comparisons streamed both ways, results in request order
+	EvaluateStream(PokerService_EvaluateStreamServer) error
+	// Task: Showdown on two boards, half the pot to the best hand on each
+	DoubleBoardShowdown(context.Context, *DoubleBoardShowdownRequest) (*DoubleBoardShowdownResponse, error)
+	// Task: Pot equity with both boards run out together
+	CalculateDoubleBoardEquity(context.Context, *DoubleBoardEquityRequest) (*DoubleBoardEquityResponse, error)
+	// Task: Pineapple discard advice from the equity of each two-card keep
+	RecommendDiscard(context.Context, *DiscardRequest) (*DiscardResponse, error)
+	// Task: Open-Face Chinese Poker scoring with fouls, royalties and Fantasyland
+	ScoreOFC(context.Context, *OFCRequest) (*OFCResponse, error)
 	mustEmbedUnimplementedPokerServiceServer()
 }
 
@@ -91,6 +363,57 @@ func (UnimplementedPokerServiceServer) CompareHands(context.Context, *CompareReq
 func (UnimplementedPokerServiceServer) CalculateProbability(context.Context, *SimRequest) (*SimResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CalculateProbability not implemented")
 }
+func (UnimplementedPokerServiceServer) CalculateHandStrength(context.Context, *HandStrengthRequest) (*HandStrengthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalculateHandStrength not implemented")
+}
+func (UnimplementedPokerServiceServer) AnalyzeBoard(context.Context, *BoardRequest) (*BoardTextureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnalyzeBoard not implemented")
+}
+func (UnimplementedPokerServiceServer) AnalyzeNuts(context.Context, *NutsRequest) (*NutsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnalyzeNuts not implemented")
+}
+func (UnimplementedPokerServiceServer) AnalyzeBlockers(context.Context, *BlockerRequest) (*BlockerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnalyzeBlockers not implemented")
+}
+func (UnimplementedPokerServiceServer) DescribeRange(context.Context, *RangeRequest) (*RangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeRange not implemented")
+}
+func (UnimplementedPokerServiceServer) CombineRanges(context.Context, *RangeOperationRequest) (*RangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CombineRanges not implemented")
+}
+func (UnimplementedPokerServiceServer) CalculateEquityHeatmap(context.Context, *HeatmapRequest) (*HeatmapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalculateEquityHeatmap not implemented")
+}
+func (UnimplementedPokerServiceServer) CalculateEquityTimeline(context.Context, *TimelineRequest) (*TimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalculateEquityTimeline not implemented")
+}
+func (UnimplementedPokerServiceServer) AnalyzeFlops(*FlopAnalysisRequest, PokerService_AnalyzeFlopsServer) error {
+	return status.Errorf(codes.Unimplemented, "method AnalyzeFlops not implemented")
+}
+func (UnimplementedPokerServiceServer) EvaluateQuery(context.Context, *QueryRequest) (*QueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvaluateQuery not implemented")
+}
+func (UnimplementedPokerServiceServer) Showdown(context.Context, *ShowdownRequest) (*ShowdownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Showdown not implemented")
+}
+func (UnimplementedPokerServiceServer) EvaluateBatch(context.Context, *BatchRequest) (*BatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvaluateBatch not implemented")
+}
+func (UnimplementedPokerServiceServer) EvaluateStream(PokerService_EvaluateStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method EvaluateStream not implemented")
+}
+func (UnimplementedPokerServiceServer) DoubleBoardShowdown(context.Context, *DoubleBoardShowdownRequest) (*DoubleBoardShowdownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DoubleBoardShowdown not implemented")
+}
+func (UnimplementedPokerServiceServer) CalculateDoubleBoardEquity(context.Context, *DoubleBoardEquityRequest) (*DoubleBoardEquityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalculateDoubleBoardEquity not implemented")
+}
+func (UnimplementedPokerServiceServer) RecommendDiscard(context.Context, *DiscardRequest) (*DiscardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecommendDiscard not implemented")
+}
+func (UnimplementedPokerServiceServer) ScoreOFC(context.Context, *OFCRequest) (*OFCResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScoreOFC not implemented")
+}
 func (UnimplementedPokerServiceServer) mustEmbedUnimplementedPokerServiceServer() {}
 
 // UnsafePokerServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -158,6 +481,323 @@ func _PokerService_CalculateProbability_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PokerService_CalculateHandStrength_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HandStrengthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokerServiceServer).CalculateHandStrength(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poker.PokerService/CalculateHandStrength",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokerServiceServer).CalculateHandStrength(ctx, req.(*HandStrengthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PokerService_AnalyzeBoard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BoardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokerServiceServer).AnalyzeBoard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poker.PokerService/AnalyzeBoard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokerServiceServer).AnalyzeBoard(ctx, req.(*BoardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PokerService_AnalyzeNuts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NutsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokerServiceServer).AnalyzeNuts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poker.PokerService/AnalyzeNuts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokerServiceServer).AnalyzeNuts(ctx, req.(*NutsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PokerService_AnalyzeBlockers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokerServiceServer).AnalyzeBlockers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poker.PokerService/AnalyzeBlockers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokerServiceServer).AnalyzeBlockers(ctx, req.(*BlockerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PokerService_DescribeRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokerServiceServer).DescribeRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poker.PokerService/DescribeRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokerServiceServer).DescribeRange(ctx, req.(*RangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PokerService_CombineRanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RangeOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokerServiceServer).CombineRanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poker.PokerService/CombineRanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokerServiceServer).CombineRanges(ctx, req.(*RangeOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PokerService_CalculateEquityHeatmap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeatmapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokerServiceServer).CalculateEquityHeatmap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poker.PokerService/CalculateEquityHeatmap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokerServiceServer).CalculateEquityHeatmap(ctx, req.(*HeatmapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PokerService_CalculateEquityTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokerServiceServer).CalculateEquityTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poker.PokerService/CalculateEquityTimeline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokerServiceServer).CalculateEquityTimeline(ctx, req.(*TimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PokerService_AnalyzeFlops_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FlopAnalysisRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PokerServiceServer).AnalyzeFlops(m, &pokerServiceAnalyzeFlopsServer{stream})
+}
+
+type PokerService_AnalyzeFlopsServer interface {
+	Send(*FlopAnalysisUpdate) error
+	grpc.ServerStream
+}
+
+type pokerServiceAnalyzeFlopsServer struct {
+	grpc.ServerStream
+}
+
+func (x *pokerServiceAnalyzeFlopsServer) Send(m *FlopAnalysisUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PokerService_EvaluateQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokerServiceServer).EvaluateQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poker.PokerService/EvaluateQuery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokerServiceServer).EvaluateQuery(ctx, req.(*QueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PokerService_Showdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShowdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokerServiceServer).Showdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poker.PokerService/Showdown",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokerServiceServer).Showdown(ctx, req.(*ShowdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PokerService_EvaluateBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokerServiceServer).EvaluateBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poker.PokerService/EvaluateBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokerServiceServer).EvaluateBatch(ctx, req.(*BatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PokerService_EvaluateStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PokerServiceServer).EvaluateStream(&pokerServiceEvaluateStreamServer{stream})
+}
+
+type PokerService_EvaluateStreamServer interface {
+	Send(*BatchResult) error
+	Recv() (*BatchItem, error)
+	grpc.ServerStream
+}
+
+type pokerServiceEvaluateStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *pokerServiceEvaluateStreamServer) Send(m *BatchResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *pokerServiceEvaluateStreamServer) Recv() (*BatchItem, error) {
+	m := new(BatchItem)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _PokerService_DoubleBoardShowdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoubleBoardShowdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokerServiceServer).DoubleBoardShowdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poker.PokerService/DoubleBoardShowdown",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokerServiceServer).DoubleBoardShowdown(ctx, req.(*DoubleBoardShowdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PokerService_CalculateDoubleBoardEquity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DoubleBoardEquityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokerServiceServer).CalculateDoubleBoardEquity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poker.PokerService/CalculateDoubleBoardEquity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokerServiceServer).CalculateDoubleBoardEquity(ctx, req.(*DoubleBoardEquityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PokerService_RecommendDiscard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokerServiceServer).RecommendDiscard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poker.PokerService/RecommendDiscard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokerServiceServer).RecommendDiscard(ctx, req.(*DiscardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PokerService_ScoreOFC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OFCRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PokerServiceServer).ScoreOFC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poker.PokerService/ScoreOFC",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PokerServiceServer).ScoreOFC(ctx, req.(*OFCRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PokerService_ServiceDesc is the grpc.ServiceDesc for PokerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -177,6 +817,204 @@ var PokerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CalculateProbability",
 			Handler:    _PokerService_CalculateProbability_Handler,
 		},
+		{
+			MethodName: "CalculateHandStrength",
+			Handler:    _PokerService_CalculateHandStrength_Handler,
+		},
+		{
+			MethodName: "AnalyzeBoard",
+			Handler:    _PokerService_AnalyzeBoard_Handler,
+		},
+		{
+			MethodName: "AnalyzeNuts",
+			Handler:    _PokerService_AnalyzeNuts_Handler,
+		},
+		{
+			MethodName: "AnalyzeBlockers",
+			Handler:    _PokerService_AnalyzeBlockers_Handler,
+		},
+		{
+			MethodName: "DescribeRange",
+			Handler:    _PokerService_DescribeRange_Handler,
+		},
+		{
+			MethodName: "CombineRanges",
+			Handler:    _PokerService_CombineRanges_Handler,
+		},
+		{
+			MethodName: "CalculateEquityHeatmap",
+			Handler:    _PokerService_CalculateEquityHeatmap_Handler,
+		},
+		{
+			MethodName: "CalculateEquityTimeline",
+			Handler:    _PokerService_CalculateEquityTimeline_Handler,
+		},
+		{
+			MethodName: "EvaluateQuery",
+			Handler:    _PokerService_EvaluateQuery_Handler,
+		},
+		{
+			MethodName: "Showdown",
+			Handler:    _PokerService_Showdown_Handler,
+		},
+		{
+			MethodName: "EvaluateBatch",
+			Handler:    _PokerService_EvaluateBatch_Handler,
+		},
+		{
+			MethodName: "DoubleBoardShowdown",
+			Handler:    _PokerService_DoubleBoardShowdown_Handler,
+		},
+		{
+			MethodName: "CalculateDoubleBoardEquity",
+			Handler:    _PokerService_CalculateDoubleBoardEquity_Handler,
+		},
+		{
+			MethodName: "RecommendDiscard",
+			Handler:    _PokerService_RecommendDiscard_Handler,
+		},
+		{
+			MethodName: "ScoreOFC",
+			Handler:    _PokerService_ScoreOFC_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "AnalyzeFlops",
+			Handler:       _PokerService_AnalyzeFlops_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "EvaluateStream",
+			Handler:       _PokerService_EvaluateStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "proto/poker.proto",
+}
+
+// ICMServiceClient is the client API for ICMService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ICMServiceClient interface {
+	// Task: stack sizes + payouts => $EV per player
+	CalculateICM(ctx context.Context, in *ICMRequest, opts ...grpc.CallOption) (*ICMResponse, error)
+	// Task: ICM-aware push/fold decision near the bubble
+	EvaluatePushFold(ctx context.Context, in *PushFoldRequest, opts ...grpc.CallOption) (*PushFoldResponse, error)
+}
+
+type iCMServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewICMServiceClient(cc grpc.ClientConnInterface) ICMServiceClient {
+	return &iCMServiceClient{cc}
+}
+
+func (c *iCMServiceClient) CalculateICM(ctx context.Context, in *ICMRequest, opts ...grpc.CallOption) (*ICMResponse, error) {
+	out := new(ICMResponse)
+	err := c.cc.Invoke(ctx, "/poker.ICMService/CalculateICM", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *iCMServiceClient) EvaluatePushFold(ctx context.Context, in *PushFoldRequest, opts ...grpc.CallOption) (*PushFoldResponse, error) {
+	out := new(PushFoldResponse)
+	err := c.cc.Invoke(ctx, "/poker.ICMService/EvaluatePushFold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ICMServiceServer is the server API for ICMService service.
+// All implementations must embed UnimplementedICMServiceServer
+// for forward compatibility
+type ICMServiceServer interface {
+	// Task: stack sizes + payouts => $EV per player
+	CalculateICM(context.Context, *ICMRequest) (*ICMResponse, error)
+	// Task: ICM-aware push/fold decision near the bubble
+	EvaluatePushFold(context.Context, *PushFoldRequest) (*PushFoldResponse, error)
+	mustEmbedUnimplementedICMServiceServer()
+}
+
+// UnimplementedICMServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedICMServiceServer struct {
+}
+
+func (UnimplementedICMServiceServer) CalculateICM(context.Context, *ICMRequest) (*ICMResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalculateICM not implemented")
+}
+func (UnimplementedICMServiceServer) EvaluatePushFold(context.Context, *PushFoldRequest) (*PushFoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvaluatePushFold not implemented")
+}
+func (UnimplementedICMServiceServer) mustEmbedUnimplementedICMServiceServer() {}
+
+// UnsafeICMServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ICMServiceServer will
+// result in compilation errors.
+type UnsafeICMServiceServer interface {
+	mustEmbedUnimplementedICMServiceServer()
+}
+
+func RegisterICMServiceServer(s grpc.ServiceRegistrar, srv ICMServiceServer) {
+	s.RegisterService(&ICMService_ServiceDesc, srv)
+}
+
+func _ICMService_CalculateICM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ICMRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ICMServiceServer).CalculateICM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poker.ICMService/CalculateICM",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ICMServiceServer).CalculateICM(ctx, req.(*ICMRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ICMService_EvaluatePushFold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushFoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ICMServiceServer).EvaluatePushFold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/poker.ICMService/EvaluatePushFold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ICMServiceServer).EvaluatePushFold(ctx, req.(*PushFoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ICMService_ServiceDesc is the grpc.ServiceDesc for ICMService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ICMService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "poker.ICMService",
+	HandlerType: (*ICMServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CalculateICM",
+			Handler:    _ICMService_CalculateICM_Handler,
+		},
+		{
+			MethodName: "EvaluatePushFold",
+			Handler:    _ICMService_EvaluatePushFold_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/poker.proto",
@@ -0,0 +1,1711 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v6.33.4
+// source: proto/v1/poker.proto
+
+package pokerv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Suit int32
+
+const (
+	Suit_SUIT_UNSPECIFIED Suit = 0
+	Suit_SUIT_HEARTS      Suit = 1
+	Suit_SUIT_DIAMONDS    Suit = 2
+	Suit_SUIT_CLUBS       Suit = 3
+	Suit_SUIT_SPADES      Suit = 4
+)
+
+// Enum value maps for Suit.
+var (
+	Suit_name = map[int32]string{
+		0: "SUIT_UNSPECIFIED",
+		1: "SUIT_HEARTS",
+		2: "SUIT_DIAMONDS",
+		3: "SUIT_CLUBS",
+		4: "SUIT_SPADES",
+	}
+	Suit_value = map[string]int32{
+		"SUIT_UNSPECIFIED": 0,
+		"SUIT_HEARTS":      1,
+		"SUIT_DIAMONDS":    2,
+		"SUIT_CLUBS":       3,
+		"SUIT_SPADES":      4,
+	}
+)
+
+func (x Suit) Enum() *Suit {
+	p := new(Suit)
+	*p = x
+	return p
+}
+
+func (x Suit) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Suit) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_poker_proto_enumTypes[0].Descriptor()
+}
+
+func (Suit) Type() protoreflect.EnumType {
+	return &file_proto_v1_poker_proto_enumTypes[0]
+}
+
+func (x Suit) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Suit.Descriptor instead.
+func (Suit) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_poker_proto_rawDescGZIP(), []int{0}
+}
+
+// Ranks carry their card value, from 2 to 14 for the ace
+type Rank int32
+
+const (
+	Rank_RANK_UNSPECIFIED Rank = 0
+	Rank_RANK_TWO         Rank = 2
+	Rank_RANK_THREE       Rank = 3
+	Rank_RANK_FOUR        Rank = 4
+	Rank_RANK_FIVE        Rank = 5
+	Rank_RANK_SIX         Rank = 6
+	Rank_RANK_SEVEN       Rank = 7
+	Rank_RANK_EIGHT       Rank = 8
+	Rank_RANK_NINE        Rank = 9
+	Rank_RANK_TEN         Rank = 10
+	Rank_RANK_JACK        Rank = 11
+	Rank_RANK_QUEEN       Rank = 12
+	Rank_RANK_KING        Rank = 13
+	Rank_RANK_ACE         Rank = 14
+)
+
+// Enum value maps for Rank.
+var (
+	Rank_name = map[int32]string{
+		0:  "RANK_UNSPECIFIED",
+		2:  "RANK_TWO",
+		3:  "RANK_THREE",
+		4:  "RANK_FOUR",
+		5:  "RANK_FIVE",
+		6:  "RANK_SIX",
+		7:  "RANK_SEVEN",
+		8:  "RANK_EIGHT",
+		9:  "RANK_NINE",
+		10: "RANK_TEN",
+		11: "RANK_JACK",
+		12: "RANK_QUEEN",
+		13: "RANK_KING",
+		14: "RANK_ACE",
+	}
+	Rank_value = map[string]int32{
+		"RANK_UNSPECIFIED": 0,
+		"RANK_TWO":         2,
+		"RANK_THREE":       3,
+		"RANK_FOUR":        4,
+		"RANK_FIVE":        5,
+		"RANK_SIX":         6,
+		"RANK_SEVEN":       7,
+		"RANK_EIGHT":       8,
+		"RANK_NINE":        9,
+		"RANK_TEN":         10,
+		"RANK_JACK":        11,
+		"RANK_QUEEN":       12,
+		"RANK_KING":        13,
+		"RANK_ACE":         14,
+	}
+)
+
+func (x Rank) Enum() *Rank {
+	p := new(Rank)
+	*p = x
+	return p
+}
+
+func (x Rank) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Rank) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_poker_proto_enumTypes[1].Descriptor()
+}
+
+func (Rank) Type() protoreflect.EnumType {
+	return &file_proto_v1_poker_proto_enumTypes[1]
+}
+
+func (x Rank) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Rank.Descriptor instead.
+func (Rank) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_poker_proto_rawDescGZIP(), []int{1}
+}
+
+type HandCategory int32
+
+const (
+	HandCategory_HAND_CATEGORY_UNSPECIFIED     HandCategory = 0
+	HandCategory_HAND_CATEGORY_HIGH_CARD       HandCategory = 1
+	HandCategory_HAND_CATEGORY_ONE_PAIR        HandCategory = 2
+	HandCategory_HAND_CATEGORY_TWO_PAIR        HandCategory = 3
+	HandCategory_HAND_CATEGORY_THREE_OF_A_KIND HandCategory = 4
+	HandCategory_HAND_CATEGORY_STRAIGHT        HandCategory = 5
+	HandCategory_HAND_CATEGORY_FLUSH           HandCategory = 6
+	HandCategory_HAND_CATEGORY_FULL_HOUSE      HandCategory = 7
+	HandCategory_HAND_CATEGORY_FOUR_OF_A_KIND  HandCategory = 8
+	HandCategory_HAND_CATEGORY_STRAIGHT_FLUSH  HandCategory = 9
+	HandCategory_HAND_CATEGORY_FIVE_OF_A_KIND  HandCategory = 10 // Only with wild cards
+)
+
+// Enum value maps for HandCategory.
+var (
+	HandCategory_name = map[int32]string{
+		0:  "HAND_CATEGORY_UNSPECIFIED",
+		1:  "HAND_CATEGORY_HIGH_CARD",
+		2:  "HAND_CATEGORY_ONE_PAIR",
+		3:  "HAND_CATEGORY_TWO_PAIR",
+		4:  "HAND_CATEGORY_THREE_OF_A_KIND",
+		5:  "HAND_CATEGORY_STRAIGHT",
+		6:  "HAND_CATEGORY_FLUSH",
+		7:  "HAND_CATEGORY_FULL_HOUSE",
+		8:  "HAND_CATEGORY_FOUR_OF_A_KIND",
+		9:  "HAND_CATEGORY_STRAIGHT_FLUSH",
+		10: "HAND_CATEGORY_FIVE_OF_A_KIND",
+	}
+	HandCategory_value = map[string]int32{
+		"HAND_CATEGORY_UNSPECIFIED":     0,
+		"HAND_CATEGORY_HIGH_CARD":       1,
+		"HAND_CATEGORY_ONE_PAIR":        2,
+		"HAND_CATEGORY_TWO_PAIR":        3,
+		"HAND_CATEGORY_THREE_OF_A_KIND": 4,
+		"HAND_CATEGORY_STRAIGHT":        5,
+		"HAND_CATEGORY_FLUSH":           6,
+		"HAND_CATEGORY_FULL_HOUSE":      7,
+		"HAND_CATEGORY_FOUR_OF_A_KIND":  8,
+		"HAND_CATEGORY_STRAIGHT_FLUSH":  9,
+		"HAND_CATEGORY_FIVE_OF_A_KIND":  10,
+	}
+)
+
+func (x HandCategory) Enum() *HandCategory {
+	p := new(HandCategory)
+	*p = x
+	return p
+}
+
+func (x HandCategory) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HandCategory) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_poker_proto_enumTypes[2].Descriptor()
+}
+
+func (HandCategory) Type() protoreflect.EnumType {
+	return &file_proto_v1_poker_proto_enumTypes[2]
+}
+
+func (x HandCategory) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HandCategory.Descriptor instead.
+func (HandCategory) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_poker_proto_rawDescGZIP(), []int{2}
+}
+
+type Variant int32
+
+const (
+	Variant_VARIANT_UNSPECIFIED    Variant = 0 // Texas Hold'em
+	Variant_VARIANT_TEXAS_HOLDEM   Variant = 1 // 2 hole cards, any five of seven
+	Variant_VARIANT_OMAHA          Variant = 2 // 4 hole cards, exactly two with three from the board
+	Variant_VARIANT_SHORT_DECK     Variant = 3 // 36-card deck, flush beats full house
+	Variant_VARIANT_RAZZ           Variant = 4 // Seven-card ace-to-five lowball
+	Variant_VARIANT_DEUCE_TO_SEVEN Variant = 5 // Five-card deuce-to-seven lowball
+)
+
+// Enum value maps for Variant.
+var (
+	Variant_name = map[int32]string{
+		0: "VARIANT_UNSPECIFIED",
+		1: "VARIANT_TEXAS_HOLDEM",
+		2: "VARIANT_OMAHA",
+		3: "VARIANT_SHORT_DECK",
+		4: "VARIANT_RAZZ",
+		5: "VARIANT_DEUCE_TO_SEVEN",
+	}
+	Variant_value = map[string]int32{
+		"VARIANT_UNSPECIFIED":    0,
+		"VARIANT_TEXAS_HOLDEM":   1,
+		"VARIANT_OMAHA":          2,
+		"VARIANT_SHORT_DECK":     3,
+		"VARIANT_RAZZ":           4,
+		"VARIANT_DEUCE_TO_SEVEN": 5,
+	}
+)
+
+func (x Variant) Enum() *Variant {
+	p := new(Variant)
+	*p = x
+	return p
+}
+
+func (x Variant) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Variant) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_poker_proto_enumTypes[3].Descriptor()
+}
+
+func (Variant) Type() protoreflect.EnumType {
+	return &file_proto_v1_poker_proto_enumTypes[3]
+}
+
+func (x Variant) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Variant.Descriptor instead.
+func (Variant) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_poker_proto_rawDescGZIP(), []int{3}
+}
+
+type TieBreakLevel int32
+
+const (
+	TieBreakLevel_TIE_BREAK_LEVEL_UNSPECIFIED    TieBreakLevel = 0
+	TieBreakLevel_TIE_BREAK_LEVEL_CATEGORY       TieBreakLevel = 1
+	TieBreakLevel_TIE_BREAK_LEVEL_PRIMARY_RANK   TieBreakLevel = 2
+	TieBreakLevel_TIE_BREAK_LEVEL_SECONDARY_RANK TieBreakLevel = 3
+	TieBreakLevel_TIE_BREAK_LEVEL_KICKER         TieBreakLevel = 4
+	TieBreakLevel_TIE_BREAK_LEVEL_TIE            TieBreakLevel = 5
+)
+
+// Enum value maps for TieBreakLevel.
+var (
+	TieBreakLevel_name = map[int32]string{
+		0: "TIE_BREAK_LEVEL_UNSPECIFIED",
+		1: "TIE_BREAK_LEVEL_CATEGORY",
+		2: "TIE_BREAK_LEVEL_PRIMARY_RANK",
+		3: "TIE_BREAK_LEVEL_SECONDARY_RANK",
+		4: "TIE_BREAK_LEVEL_KICKER",
+		5: "TIE_BREAK_LEVEL_TIE",
+	}
+	TieBreakLevel_value = map[string]int32{
+		"TIE_BREAK_LEVEL_UNSPECIFIED":    0,
+		"TIE_BREAK_LEVEL_CATEGORY":       1,
+		"TIE_BREAK_LEVEL_PRIMARY_RANK":   2,
+		"TIE_BREAK_LEVEL_SECONDARY_RANK": 3,
+		"TIE_BREAK_LEVEL_KICKER":         4,
+		"TIE_BREAK_LEVEL_TIE":            5,
+	}
+)
+
+func (x TieBreakLevel) Enum() *TieBreakLevel {
+	p := new(TieBreakLevel)
+	*p = x
+	return p
+}
+
+func (x TieBreakLevel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TieBreakLevel) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_poker_proto_enumTypes[4].Descriptor()
+}
+
+func (TieBreakLevel) Type() protoreflect.EnumType {
+	return &file_proto_v1_poker_proto_enumTypes[4]
+}
+
+func (x TieBreakLevel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TieBreakLevel.Descriptor instead.
+func (TieBreakLevel) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_poker_proto_rawDescGZIP(), []int{4}
+}
+
+type Estimator int32
+
+const (
+	Estimator_ESTIMATOR_NAIVE        Estimator = 0
+	Estimator_ESTIMATOR_STRATIFIED   Estimator = 1
+	Estimator_ESTIMATOR_ANTITHETIC   Estimator = 2
+	Estimator_ESTIMATOR_QUASI_RANDOM Estimator = 3
+)
+
+// Enum value maps for Estimator.
+var (
+	Estimator_name = map[int32]string{
+		0: "ESTIMATOR_NAIVE",
+		1: "ESTIMATOR_STRATIFIED",
+		2: "ESTIMATOR_ANTITHETIC",
+		3: "ESTIMATOR_QUASI_RANDOM",
+	}
+	Estimator_value = map[string]int32{
+		"ESTIMATOR_NAIVE":        0,
+		"ESTIMATOR_STRATIFIED":   1,
+		"ESTIMATOR_ANTITHETIC":   2,
+		"ESTIMATOR_QUASI_RANDOM": 3,
+	}
+)
+
+func (x Estimator) Enum() *Estimator {
+	p := new(Estimator)
+	*p = x
+	return p
+}
+
+func (x Estimator) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Estimator) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_poker_proto_enumTypes[5].Descriptor()
+}
+
+func (Estimator) Type() protoreflect.EnumType {
+	return &file_proto_v1_poker_proto_enumTypes[5]
+}
+
+func (x Estimator) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Estimator.Descriptor instead.
+func (Estimator) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_poker_proto_rawDescGZIP(), []int{5}
+}
+
+type Card struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rank  Rank  `protobuf:"varint,1,opt,name=rank,proto3,enum=poker.v1.Rank" json:"rank,omitempty"`
+	Suit  Suit  `protobuf:"varint,2,opt,name=suit,proto3,enum=poker.v1.Suit" json:"suit,omitempty"`
+	Joker int32 `protobuf:"varint,3,opt,name=joker,proto3" json:"joker,omitempty"` // 1 or 2 for a joker, which has no rank or suit
+}
+
+func (x *Card) Reset() {
+	*x = Card{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_poker_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Card) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Card) ProtoMessage() {}
+
+func (x *Card) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_poker_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Card.ProtoReflect.Descriptor instead.
+func (*Card) Descriptor() ([]byte, []int) {
+	return file_proto_v1_poker_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Card) GetRank() Rank {
+	if x != nil {
+		return x.Rank
+	}
+	return Rank_RANK_UNSPECIFIED
+}
+
+func (x *Card) GetSuit() Suit {
+	if x != nil {
+		return x.Suit
+	}
+	return Suit_SUIT_UNSPECIFIED
+}
+
+func (x *Card) GetJoker() int32 {
+	if x != nil {
+		return x.Joker
+	}
+	return 0
+}
+
+type HandRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Variant        Variant    `protobuf:"varint,1,opt,name=variant,proto3,enum=poker.v1.Variant" json:"variant,omitempty"`
+	HoleCards      []*Card    `protobuf:"bytes,2,rep,name=hole_cards,json=holeCards,proto3" json:"hole_cards,omitempty"`
+	CommunityCards []*Card    `protobuf:"bytes,3,rep,name=community_cards,json=communityCards,proto3" json:"community_cards,omitempty"`
+	Wild           *WildCards `protobuf:"bytes,4,opt,name=wild,proto3" json:"wild,omitempty"` // Texas Hold'em only
+}
+
+func (x *HandRequest) Reset() {
+	*x = HandRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_poker_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandRequest) ProtoMessage() {}
+
+func (x *HandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_poker_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandRequest.ProtoReflect.Descriptor instead.
+func (*HandRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_poker_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *HandRequest) GetVariant() Variant {
+	if x != nil {
+		return x.Variant
+	}
+	return Variant_VARIANT_UNSPECIFIED
+}
+
+func (x *HandRequest) GetHoleCards() []*Card {
+	if x != nil {
+		return x.HoleCards
+	}
+	return nil
+}
+
+func (x *HandRequest) GetCommunityCards() []*Card {
+	if x != nil {
+		return x.CommunityCards
+	}
+	return nil
+}
+
+func (x *HandRequest) GetWild() *WildCards {
+	if x != nil {
+		return x.Wild
+	}
+	return nil
+}
+
+type WildCards struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Jokers   int32 `protobuf:"varint,1,opt,name=jokers,proto3" json:"jokers,omitempty"`                                        // Jokers added to the deck, 0 to 2
+	Bug      bool  `protobuf:"varint,2,opt,name=bug,proto3" json:"bug,omitempty"`                                              // Jokers only play as aces or to complete straights and flushes
+	WildRank Rank  `protobuf:"varint,3,opt,name=wild_rank,json=wildRank,proto3,enum=poker.v1.Rank" json:"wild_rank,omitempty"` // Every card of this rank is wild, e.g. RANK_TWO for deuces wild
+}
+
+func (x *WildCards) Reset() {
+	*x = WildCards{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_poker_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WildCards) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WildCards) ProtoMessage() {}
+
+func (x *WildCards) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_poker_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WildCards.ProtoReflect.Descriptor instead.
+func (*WildCards) Descriptor() ([]byte, []int) {
+	return file_proto_v1_poker_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WildCards) GetJokers() int32 {
+	if x != nil {
+		return x.Jokers
+	}
+	return 0
+}
+
+func (x *WildCards) GetBug() bool {
+	if x != nil {
+		return x.Bug
+	}
+	return false
+}
+
+func (x *WildCards) GetWildRank() Rank {
+	if x != nil {
+		return x.WildRank
+	}
+	return Rank_RANK_UNSPECIFIED
+}
+
+type Substitution struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WildCard *Card `protobuf:"bytes,1,opt,name=wild_card,json=wildCard,proto3" json:"wild_card,omitempty"`
+	PlaysAs  *Card `protobuf:"bytes,2,opt,name=plays_as,json=playsAs,proto3" json:"plays_as,omitempty"`
+}
+
+func (x *Substitution) Reset() {
+	*x = Substitution{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_poker_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Substitution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Substitution) ProtoMessage() {}
+
+func (x *Substitution) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_poker_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Substitution.ProtoReflect.Descriptor instead.
+func (*Substitution) Descriptor() ([]byte, []int) {
+	return file_proto_v1_poker_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Substitution) GetWildCard() *Card {
+	if x != nil {
+		return x.WildCard
+	}
+	return nil
+}
+
+func (x *Substitution) GetPlaysAs() *Card {
+	if x != nil {
+		return x.PlaysAs
+	}
+	return nil
+}
+
+type HandResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Category             HandCategory    `protobuf:"varint,1,opt,name=category,proto3,enum=poker.v1.HandCategory" json:"category,omitempty"`
+	CategoryName         string          `protobuf:"bytes,2,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"` // e.g. "Full House"
+	RankValue            int32           `protobuf:"varint,3,opt,name=rank_value,json=rankValue,proto3" json:"rank_value,omitempty"`         // Higher is better within a variant
+	BestCards            []*Card         `protobuf:"bytes,4,rep,name=best_cards,json=bestCards,proto3" json:"best_cards,omitempty"`
+	Draws                []*Draw         `protobuf:"bytes,5,rep,name=draws,proto3" json:"draws,omitempty"`
+	EquivalenceClass     int32           `protobuf:"varint,6,opt,name=equivalence_class,json=equivalenceClass,proto3" json:"equivalence_class,omitempty"` // 1 (royal flush) to 7462 (7-5-4-3-2 offsuit)
+	FiveCardProbability  float64         `protobuf:"fixed64,7,opt,name=five_card_probability,json=fiveCardProbability,proto3" json:"five_card_probability,omitempty"`
+	SevenCardProbability float64         `protobuf:"fixed64,8,opt,name=seven_card_probability,json=sevenCardProbability,proto3" json:"seven_card_probability,omitempty"`
+	Percentile           float64         `protobuf:"fixed64,9,opt,name=percentile,proto3" json:"percentile,omitempty"`
+	HandLabel            string          `protobuf:"bytes,10,opt,name=hand_label,json=handLabel,proto3" json:"hand_label,omitempty"` // e.g. "Top Pair, Top Kicker"
+	HoleCardsUsed        int32           `protobuf:"varint,11,opt,name=hole_cards_used,json=holeCardsUsed,proto3" json:"hole_cards_used,omitempty"`
+	Substitutions        []*Substitution `protobuf:"bytes,12,rep,name=substitutions,proto3" json:"substitutions,omitempty"` // What each wild card in best_cards plays as
+}
+
+func (x *HandResponse) Reset() {
+	*x = HandResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_poker_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HandResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandResponse) ProtoMessage() {}
+
+func (x *HandResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_poker_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandResponse.ProtoReflect.Descriptor instead.
+func (*HandResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_poker_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *HandResponse) GetCategory() HandCategory {
+	if x != nil {
+		return x.Category
+	}
+	return HandCategory_HAND_CATEGORY_UNSPECIFIED
+}
+
+func (x *HandResponse) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *HandResponse) GetRankValue() int32 {
+	if x != nil {
+		return x.RankValue
+	}
+	return 0
+}
+
+func (x *HandResponse) GetBestCards() []*Card {
+	if x != nil {
+		return x.BestCards
+	}
+	return nil
+}
+
+func (x *HandResponse) GetDraws() []*Draw {
+	if x != nil {
+		return x.Draws
+	}
+	return nil
+}
+
+func (x *HandResponse) GetEquivalenceClass() int32 {
+	if x != nil {
+		return x.EquivalenceClass
+	}
+	return 0
+}
+
+func (x *HandResponse) GetFiveCardProbability() float64 {
+	if x != nil {
+		return x.FiveCardProbability
+	}
+	return 0
+}
+
+func (x *HandResponse) GetSevenCardProbability() float64 {
+	if x != nil {
+		return x.SevenCardProbability
+	}
+	return 0
+}
+
+func (x *HandResponse) GetPercentile() float64 {
+	if x != nil {
+		return x.Percentile
+	}
+	return 0
+}
+
+func (x *HandResponse) GetHandLabel() string {
+	if x != nil {
+		return x.HandLabel
+	}
+	return ""
+}
+
+func (x *HandResponse) GetHoleCardsUsed() int32 {
+	if x != nil {
+		return x.HoleCardsUsed
+	}
+	return 0
+}
+
+func (x *HandResponse) GetSubstitutions() []*Substitution {
+	if x != nil {
+		return x.Substitutions
+	}
+	return nil
+}
+
+type Draw struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Outs []*Card `protobuf:"bytes,2,rep,name=outs,proto3" json:"outs,omitempty"`
+}
+
+func (x *Draw) Reset() {
+	*x = Draw{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_poker_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Draw) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Draw) ProtoMessage() {}
+
+func (x *Draw) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_poker_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Draw.ProtoReflect.Descriptor instead.
+func (*Draw) Descriptor() ([]byte, []int) {
+	return file_proto_v1_poker_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Draw) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Draw) GetOuts() []*Card {
+	if x != nil {
+		return x.Outs
+	}
+	return nil
+}
+
+type CompareRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hand1 *HandRequest `protobuf:"bytes,1,opt,name=hand1,proto3" json:"hand1,omitempty"`
+	Hand2 *HandRequest `protobuf:"bytes,2,opt,name=hand2,proto3" json:"hand2,omitempty"`
+}
+
+func (x *CompareRequest) Reset() {
+	*x = CompareRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_poker_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareRequest) ProtoMessage() {}
+
+func (x *CompareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_poker_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareRequest.ProtoReflect.Descriptor instead.
+func (*CompareRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_poker_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CompareRequest) GetHand1() *HandRequest {
+	if x != nil {
+		return x.Hand1
+	}
+	return nil
+}
+
+func (x *CompareRequest) GetHand2() *HandRequest {
+	if x != nil {
+		return x.Hand2
+	}
+	return nil
+}
+
+type CompareResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Winner      int32         `protobuf:"varint,1,opt,name=winner,proto3" json:"winner,omitempty"` // 1 for hand1, 2 for hand2, 0 for tie
+	Hand1Result *HandResponse `protobuf:"bytes,2,opt,name=hand1_result,json=hand1Result,proto3" json:"hand1_result,omitempty"`
+	Hand2Result *HandResponse `protobuf:"bytes,3,opt,name=hand2_result,json=hand2Result,proto3" json:"hand2_result,omitempty"`
+	TieBreak    *TieBreak     `protobuf:"bytes,4,opt,name=tie_break,json=tieBreak,proto3" json:"tie_break,omitempty"`
+}
+
+func (x *CompareResponse) Reset() {
+	*x = CompareResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_poker_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompareResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareResponse) ProtoMessage() {}
+
+func (x *CompareResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_poker_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareResponse.ProtoReflect.Descriptor instead.
+func (*CompareResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_poker_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CompareResponse) GetWinner() int32 {
+	if x != nil {
+		return x.Winner
+	}
+	return 0
+}
+
+func (x *CompareResponse) GetHand1Result() *HandResponse {
+	if x != nil {
+		return x.Hand1Result
+	}
+	return nil
+}
+
+func (x *CompareResponse) GetHand2Result() *HandResponse {
+	if x != nil {
+		return x.Hand2Result
+	}
+	return nil
+}
+
+func (x *CompareResponse) GetTieBreak() *TieBreak {
+	if x != nil {
+		return x.TieBreak
+	}
+	return nil
+}
+
+type TieBreak struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Level           TieBreakLevel `protobuf:"varint,1,opt,name=level,proto3,enum=poker.v1.TieBreakLevel" json:"level,omitempty"`
+	Kicker          int32         `protobuf:"varint,2,opt,name=kicker,proto3" json:"kicker,omitempty"`                          // 1-based kicker that decided, 0 otherwise
+	Hand1Cards      []*Card       `protobuf:"bytes,3,rep,name=hand1_cards,json=hand1Cards,proto3" json:"hand1_cards,omitempty"` // Hand 1's cards at the deciding level
+	Hand2Cards      []*Card       `protobuf:"bytes,4,rep,name=hand2_cards,json=hand2Cards,proto3" json:"hand2_cards,omitempty"`
+	Hand1BoardPlays bool          `protobuf:"varint,5,opt,name=hand1_board_plays,json=hand1BoardPlays,proto3" json:"hand1_board_plays,omitempty"`
+	Hand2BoardPlays bool          `protobuf:"varint,6,opt,name=hand2_board_plays,json=hand2BoardPlays,proto3" json:"hand2_board_plays,omitempty"`
+	Explanation     string        `protobuf:"bytes,7,opt,name=explanation,proto3" json:"explanation,omitempty"`
+}
+
+func (x *TieBreak) Reset() {
+	*x = TieBreak{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_poker_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TieBreak) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TieBreak) ProtoMessage() {}
+
+func (x *TieBreak) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_poker_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TieBreak.ProtoReflect.Descriptor instead.
+func (*TieBreak) Descriptor() ([]byte, []int) {
+	return file_proto_v1_poker_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TieBreak) GetLevel() TieBreakLevel {
+	if x != nil {
+		return x.Level
+	}
+	return TieBreakLevel_TIE_BREAK_LEVEL_UNSPECIFIED
+}
+
+func (x *TieBreak) GetKicker() int32 {
+	if x != nil {
+		return x.Kicker
+	}
+	return 0
+}
+
+func (x *TieBreak) GetHand1Cards() []*Card {
+	if x != nil {
+		return x.Hand1Cards
+	}
+	return nil
+}
+
+func (x *TieBreak) GetHand2Cards() []*Card {
+	if x != nil {
+		return x.Hand2Cards
+	}
+	return nil
+}
+
+func (x *TieBreak) GetHand1BoardPlays() bool {
+	if x != nil {
+		return x.Hand1BoardPlays
+	}
+	return false
+}
+
+func (x *TieBreak) GetHand2BoardPlays() bool {
+	if x != nil {
+		return x.Hand2BoardPlays
+	}
+	return false
+}
+
+func (x *TieBreak) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+type SimRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Variant        Variant    `protobuf:"varint,1,opt,name=variant,proto3,enum=poker.v1.Variant" json:"variant,omitempty"`
+	HoleCards      []*Card    `protobuf:"bytes,2,rep,name=hole_cards,json=holeCards,proto3" json:"hole_cards,omitempty"`
+	CommunityCards []*Card    `protobuf:"bytes,3,rep,name=community_cards,json=communityCards,proto3" json:"community_cards,omitempty"`
+	NumSimulations int32      `protobuf:"varint,4,opt,name=num_simulations,json=numSimulations,proto3" json:"num_simulations,omitempty"`
+	Estimator      Estimator  `protobuf:"varint,5,opt,name=estimator,proto3,enum=poker.v1.Estimator" json:"estimator,omitempty"`
+	Wild           *WildCards `protobuf:"bytes,6,opt,name=wild,proto3" json:"wild,omitempty"` // Deal from a deck with these wild cards, naive estimator only
+}
+
+func (x *SimRequest) Reset() {
+	*x = SimRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_poker_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimRequest) ProtoMessage() {}
+
+func (x *SimRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_poker_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimRequest.ProtoReflect.Descriptor instead.
+func (*SimRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_poker_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SimRequest) GetVariant() Variant {
+	if x != nil {
+		return x.Variant
+	}
+	return Variant_VARIANT_UNSPECIFIED
+}
+
+func (x *SimRequest) GetHoleCards() []*Card {
+	if x != nil {
+		return x.HoleCards
+	}
+	return nil
+}
+
+func (x *SimRequest) GetCommunityCards() []*Card {
+	if x != nil {
+		return x.CommunityCards
+	}
+	return nil
+}
+
+func (x *SimRequest) GetNumSimulations() int32 {
+	if x != nil {
+		return x.NumSimulations
+	}
+	return 0
+}
+
+func (x *SimRequest) GetEstimator() Estimator {
+	if x != nil {
+		return x.Estimator
+	}
+	return Estimator_ESTIMATOR_NAIVE
+}
+
+func (x *SimRequest) GetWild() *WildCards {
+	if x != nil {
+		return x.Wild
+	}
+	return nil
+}
+
+type SimResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WinProbability  float64   `protobuf:"fixed64,1,opt,name=win_probability,json=winProbability,proto3" json:"win_probability,omitempty"`
+	TieProbability  float64   `protobuf:"fixed64,2,opt,name=tie_probability,json=tieProbability,proto3" json:"tie_probability,omitempty"`
+	LoseProbability float64   `protobuf:"fixed64,3,opt,name=lose_probability,json=loseProbability,proto3" json:"lose_probability,omitempty"`
+	SimulationsRun  int32     `protobuf:"varint,4,opt,name=simulations_run,json=simulationsRun,proto3" json:"simulations_run,omitempty"`
+	EstimatorUsed   Estimator `protobuf:"varint,5,opt,name=estimator_used,json=estimatorUsed,proto3,enum=poker.v1.Estimator" json:"estimator_used,omitempty"`
+	StandardError   float64   `protobuf:"fixed64,6,opt,name=standard_error,json=standardError,proto3" json:"standard_error,omitempty"`
+}
+
+func (x *SimResponse) Reset() {
+	*x = SimResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v1_poker_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimResponse) ProtoMessage() {}
+
+func (x *SimResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_poker_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimResponse.ProtoReflect.Descriptor instead.
+func (*SimResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_poker_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SimResponse) GetWinProbability() float64 {
+	if x != nil {
+		return x.WinProbability
+	}
+	return 0
+}
+
+func (x *SimResponse) GetTieProbability() float64 {
+	if x != nil {
+		return x.TieProbability
+	}
+	return 0
+}
+
+func (x *SimResponse) GetLoseProbability() float64 {
+	if x != nil {
+		return x.LoseProbability
+	}
+	return 0
+}
+
+func (x *SimResponse) GetSimulationsRun() int32 {
+	if x != nil {
+		return x.SimulationsRun
+	}
+	return 0
+}
+
+func (x *SimResponse) GetEstimatorUsed() Estimator {
+	if x != nil {
+		return x.EstimatorUsed
+	}
+	return Estimator_ESTIMATOR_NAIVE
+}
+
+func (x *SimResponse) GetStandardError() float64 {
+	if x != nil {
+		return x.StandardError
+	}
+	return 0
+}
+
+var File_proto_v1_poker_proto protoreflect.FileDescriptor
+
+var file_proto_v1_poker_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6f, 0x6b, 0x65, 0x72,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x22, 0x64, 0x0a, 0x04, 0x43, 0x61, 0x72, 0x64, 0x12, 0x22, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x22, 0x0a, 0x04,
+	0x73, 0x75, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x70, 0x6f, 0x6b,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x69, 0x74, 0x52, 0x04, 0x73, 0x75, 0x69, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x6a, 0x6f, 0x6b, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x6a, 0x6f, 0x6b, 0x65, 0x72, 0x22, 0xcb, 0x01, 0x0a, 0x0b, 0x48, 0x61, 0x6e, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x52, 0x07, 0x76, 0x61, 0x72, 0x69,
+	0x61, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x0a, 0x68, 0x6f, 0x6c, 0x65, 0x5f, 0x63, 0x61, 0x72, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x52, 0x09, 0x68, 0x6f, 0x6c, 0x65, 0x43, 0x61, 0x72,
+	0x64, 0x73, 0x12, 0x37, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x5f,
+	0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x6f,
+	0x6b, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x52, 0x0e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x43, 0x61, 0x72, 0x64, 0x73, 0x12, 0x27, 0x0a, 0x04, 0x77,
+	0x69, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x6b, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x69, 0x6c, 0x64, 0x43, 0x61, 0x72, 0x64, 0x73, 0x52, 0x04,
+	0x77, 0x69, 0x6c, 0x64, 0x22, 0x62, 0x0a, 0x09, 0x57, 0x69, 0x6c, 0x64, 0x43, 0x61, 0x72, 0x64,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x6a, 0x6f, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x6a, 0x6f, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x75, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x62, 0x75, 0x67, 0x12, 0x2b, 0x0a, 0x09, 0x77,
+	0x69, 0x6c, 0x64, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e,
+	0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x52, 0x08,
+	0x77, 0x69, 0x6c, 0x64, 0x52, 0x61, 0x6e, 0x6b, 0x22, 0x66, 0x0a, 0x0c, 0x53, 0x75, 0x62, 0x73,
+	0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x09, 0x77, 0x69, 0x6c, 0x64,
+	0x5f, 0x63, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x6f,
+	0x6b, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x52, 0x08, 0x77, 0x69, 0x6c,
+	0x64, 0x43, 0x61, 0x72, 0x64, 0x12, 0x29, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x73, 0x5f, 0x61,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x73, 0x41, 0x73,
+	0x22, 0x97, 0x04, 0x0a, 0x0c, 0x48, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x32, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x48,
+	0x61, 0x6e, 0x64, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x08, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x61,
+	0x6e, 0x6b, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x72, 0x61, 0x6e, 0x6b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2d, 0x0a, 0x0a, 0x62, 0x65, 0x73,
+	0x74, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x52, 0x09, 0x62,
+	0x65, 0x73, 0x74, 0x43, 0x61, 0x72, 0x64, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x64, 0x72, 0x61, 0x77,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x72, 0x61, 0x77, 0x52, 0x05, 0x64, 0x72, 0x61, 0x77, 0x73, 0x12, 0x2b,
+	0x0a, 0x11, 0x65, 0x71, 0x75, 0x69, 0x76, 0x61, 0x6c, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x63, 0x6c,
+	0x61, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x65, 0x71, 0x75, 0x69, 0x76,
+	0x61, 0x6c, 0x65, 0x6e, 0x63, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x66,
+	0x69, 0x76, 0x65, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x66, 0x69, 0x76, 0x65,
+	0x43, 0x61, 0x72, 0x64, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12,
+	0x34, 0x0a, 0x16, 0x73, 0x65, 0x76, 0x65, 0x6e, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x72,
+	0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x14, 0x73, 0x65, 0x76, 0x65, 0x6e, 0x43, 0x61, 0x72, 0x64, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x69, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x69, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x61, 0x6e, 0x64, 0x5f, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x68, 0x61, 0x6e, 0x64, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x12, 0x26, 0x0a, 0x0f, 0x68, 0x6f, 0x6c, 0x65, 0x5f, 0x63, 0x61, 0x72,
+	0x64, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x68,
+	0x6f, 0x6c, 0x65, 0x43, 0x61, 0x72, 0x64, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x3c, 0x0a, 0x0d,
+	0x73, 0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0c, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x75, 0x62, 0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x75, 0x62,
+	0x73, 0x74, 0x69, 0x74, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x3e, 0x0a, 0x04, 0x44, 0x72,
+	0x61, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x6f, 0x75, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x61, 0x72, 0x64, 0x52, 0x04, 0x6f, 0x75, 0x74, 0x73, 0x22, 0x6a, 0x0a, 0x0e, 0x43, 0x6f,
+	0x6d, 0x70, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x05,
+	0x68, 0x61, 0x6e, 0x64, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x6f,
+	0x6b, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x05, 0x68, 0x61, 0x6e, 0x64, 0x31, 0x12, 0x2b, 0x0a, 0x05, 0x68, 0x61, 0x6e,
+	0x64, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x05, 0x68, 0x61, 0x6e, 0x64, 0x32, 0x22, 0xd0, 0x01, 0x0a, 0x0f, 0x43, 0x6f, 0x6d, 0x70, 0x61,
+	0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x69,
+	0x6e, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x6e,
+	0x65, 0x72, 0x12, 0x39, 0x0a, 0x0c, 0x68, 0x61, 0x6e, 0x64, 0x31, 0x5f, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x52, 0x0b, 0x68, 0x61, 0x6e, 0x64, 0x31, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x39, 0x0a,
+	0x0c, 0x68, 0x61, 0x6e, 0x64, 0x32, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x48,
+	0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0b, 0x68, 0x61, 0x6e,
+	0x64, 0x32, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2f, 0x0a, 0x09, 0x74, 0x69, 0x65, 0x5f,
+	0x62, 0x72, 0x65, 0x61, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x6f,
+	0x6b, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x52,
+	0x08, 0x74, 0x69, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x22, 0xad, 0x02, 0x0a, 0x08, 0x54, 0x69,
+	0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x12, 0x2d, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x69, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x6b, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6b, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x2f, 0x0a,
+	0x0b, 0x68, 0x61, 0x6e, 0x64, 0x31, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61,
+	0x72, 0x64, 0x52, 0x0a, 0x68, 0x61, 0x6e, 0x64, 0x31, 0x43, 0x61, 0x72, 0x64, 0x73, 0x12, 0x2f,
+	0x0a, 0x0b, 0x68, 0x61, 0x6e, 0x64, 0x32, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x61, 0x72, 0x64, 0x52, 0x0a, 0x68, 0x61, 0x6e, 0x64, 0x32, 0x43, 0x61, 0x72, 0x64, 0x73, 0x12,
+	0x2a, 0x0a, 0x11, 0x68, 0x61, 0x6e, 0x64, 0x31, 0x5f, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x70,
+	0x6c, 0x61, 0x79, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x68, 0x61, 0x6e, 0x64,
+	0x31, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x68,
+	0x61, 0x6e, 0x64, 0x32, 0x5f, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x68, 0x61, 0x6e, 0x64, 0x32, 0x42, 0x6f, 0x61,
+	0x72, 0x64, 0x50, 0x6c, 0x61, 0x79, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78,
+	0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa6, 0x02, 0x0a, 0x0a, 0x53, 0x69,
+	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x07, 0x76, 0x61, 0x72, 0x69,
+	0x61, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x6f, 0x6b, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x52, 0x07, 0x76, 0x61,
+	0x72, 0x69, 0x61, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x0a, 0x68, 0x6f, 0x6c, 0x65, 0x5f, 0x63, 0x61,
+	0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x6f, 0x6b, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x52, 0x09, 0x68, 0x6f, 0x6c, 0x65, 0x43,
+	0x61, 0x72, 0x64, 0x73, 0x12, 0x37, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74,
+	0x79, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x52, 0x0e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x43, 0x61, 0x72, 0x64, 0x73, 0x12, 0x27, 0x0a,
+	0x0f, 0x6e, 0x75, 0x6d, 0x5f, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6e, 0x75, 0x6d, 0x53, 0x69, 0x6d, 0x75, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x31, 0x0a, 0x09, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61,
+	0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x6b, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x09,
+	0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x27, 0x0a, 0x04, 0x77, 0x69, 0x6c,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x57, 0x69, 0x6c, 0x64, 0x43, 0x61, 0x72, 0x64, 0x73, 0x52, 0x04, 0x77, 0x69,
+	0x6c, 0x64, 0x22, 0x96, 0x02, 0x0a, 0x0b, 0x53, 0x69, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x77, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x77, 0x69, 0x6e,
+	0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x74,
+	0x69, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x74, 0x69, 0x65, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x6c, 0x6f, 0x73, 0x65, 0x5f, 0x70, 0x72, 0x6f,
+	0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f,
+	0x6c, 0x6f, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12,
+	0x27, 0x0a, 0x0f, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x72,
+	0x75, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x75, 0x6e, 0x12, 0x3a, 0x0a, 0x0e, 0x65, 0x73, 0x74, 0x69,
+	0x6d, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x13, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x73, 0x74, 0x69,
+	0x6d, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0d, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x6f, 0x72,
+	0x55, 0x73, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x61, 0x72, 0x64,
+	0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x73, 0x74,
+	0x61, 0x6e, 0x64, 0x61, 0x72, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x2a, 0x61, 0x0a, 0x04, 0x53,
+	0x75, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x55, 0x49, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x55, 0x49,
+	0x54, 0x5f, 0x48, 0x45, 0x41, 0x52, 0x54, 0x53, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x55,
+	0x49, 0x54, 0x5f, 0x44, 0x49, 0x41, 0x4d, 0x4f, 0x4e, 0x44, 0x53, 0x10, 0x02, 0x12, 0x0e, 0x0a,
+	0x0a, 0x53, 0x55, 0x49, 0x54, 0x5f, 0x43, 0x4c, 0x55, 0x42, 0x53, 0x10, 0x03, 0x12, 0x0f, 0x0a,
+	0x0b, 0x53, 0x55, 0x49, 0x54, 0x5f, 0x53, 0x50, 0x41, 0x44, 0x45, 0x53, 0x10, 0x04, 0x2a, 0xdf,
+	0x01, 0x0a, 0x04, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x41, 0x4e, 0x4b, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a,
+	0x08, 0x52, 0x41, 0x4e, 0x4b, 0x5f, 0x54, 0x57, 0x4f, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x52,
+	0x41, 0x4e, 0x4b, 0x5f, 0x54, 0x48, 0x52, 0x45, 0x45, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x52,
+	0x41, 0x4e, 0x4b, 0x5f, 0x46, 0x4f, 0x55, 0x52, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x52, 0x41,
+	0x4e, 0x4b, 0x5f, 0x46, 0x49, 0x56, 0x45, 0x10, 0x05, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x41, 0x4e,
+	0x4b, 0x5f, 0x53, 0x49, 0x58, 0x10, 0x06, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x41, 0x4e, 0x4b, 0x5f,
+	0x53, 0x45, 0x56, 0x45, 0x4e, 0x10, 0x07, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x41, 0x4e, 0x4b, 0x5f,
+	0x45, 0x49, 0x47, 0x48, 0x54, 0x10, 0x08, 0x12, 0x0d, 0x0a, 0x09, 0x52, 0x41, 0x4e, 0x4b, 0x5f,
+	0x4e, 0x49, 0x4e, 0x45, 0x10, 0x09, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x41, 0x4e, 0x4b, 0x5f, 0x54,
+	0x45, 0x4e, 0x10, 0x0a, 0x12, 0x0d, 0x0a, 0x09, 0x52, 0x41, 0x4e, 0x4b, 0x5f, 0x4a, 0x41, 0x43,
+	0x4b, 0x10, 0x0b, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x41, 0x4e, 0x4b, 0x5f, 0x51, 0x55, 0x45, 0x45,
+	0x4e, 0x10, 0x0c, 0x12, 0x0d, 0x0a, 0x09, 0x52, 0x41, 0x4e, 0x4b, 0x5f, 0x4b, 0x49, 0x4e, 0x47,
+	0x10, 0x0d, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x41, 0x4e, 0x4b, 0x5f, 0x41, 0x43, 0x45, 0x10, 0x0e,
+	0x2a, 0xde, 0x02, 0x0a, 0x0c, 0x48, 0x61, 0x6e, 0x64, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x12, 0x1d, 0x0a, 0x19, 0x48, 0x41, 0x4e, 0x44, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f,
+	0x52, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x1b, 0x0a, 0x17, 0x48, 0x41, 0x4e, 0x44, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52,
+	0x59, 0x5f, 0x48, 0x49, 0x47, 0x48, 0x5f, 0x43, 0x41, 0x52, 0x44, 0x10, 0x01, 0x12, 0x1a, 0x0a,
+	0x16, 0x48, 0x41, 0x4e, 0x44, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x4f,
+	0x4e, 0x45, 0x5f, 0x50, 0x41, 0x49, 0x52, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x48, 0x41, 0x4e,
+	0x44, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x54, 0x57, 0x4f, 0x5f, 0x50,
+	0x41, 0x49, 0x52, 0x10, 0x03, 0x12, 0x21, 0x0a, 0x1d, 0x48, 0x41, 0x4e, 0x44, 0x5f, 0x43, 0x41,
+	0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x54, 0x48, 0x52, 0x45, 0x45, 0x5f, 0x4f, 0x46, 0x5f,
+	0x41, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16, 0x48, 0x41, 0x4e, 0x44,
+	0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x49, 0x47,
+	0x48, 0x54, 0x10, 0x05, 0x12, 0x17, 0x0a, 0x13, 0x48, 0x41, 0x4e, 0x44, 0x5f, 0x43, 0x41, 0x54,
+	0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x46, 0x4c, 0x55, 0x53, 0x48, 0x10, 0x06, 0x12, 0x1c, 0x0a,
+	0x18, 0x48, 0x41, 0x4e, 0x44, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x46,
+	0x55, 0x4c, 0x4c, 0x5f, 0x48, 0x4f, 0x55, 0x53, 0x45, 0x10, 0x07, 0x12, 0x20, 0x0a, 0x1c, 0x48,
+	0x41, 0x4e, 0x44, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x46, 0x4f, 0x55,
+	0x52, 0x5f, 0x4f, 0x46, 0x5f, 0x41, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x10, 0x08, 0x12, 0x20, 0x0a,
+	0x1c, 0x48, 0x41, 0x4e, 0x44, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x53,
+	0x54, 0x52, 0x41, 0x49, 0x47, 0x48, 0x54, 0x5f, 0x46, 0x4c, 0x55, 0x53, 0x48, 0x10, 0x09, 0x12,
+	0x20, 0x0a, 0x1c, 0x48, 0x41, 0x4e, 0x44, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59,
+	0x5f, 0x46, 0x49, 0x56, 0x45, 0x5f, 0x4f, 0x46, 0x5f, 0x41, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x10,
+	0x0a, 0x2a, 0x95, 0x01, 0x0a, 0x07, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x12, 0x17, 0x0a,
+	0x13, 0x56, 0x41, 0x52, 0x49, 0x41, 0x4e, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x56, 0x41, 0x52, 0x49, 0x41, 0x4e,
+	0x54, 0x5f, 0x54, 0x45, 0x58, 0x41, 0x53, 0x5f, 0x48, 0x4f, 0x4c, 0x44, 0x45, 0x4d, 0x10, 0x01,
+	0x12, 0x11, 0x0a, 0x0d, 0x56, 0x41, 0x52, 0x49, 0x41, 0x4e, 0x54, 0x5f, 0x4f, 0x4d, 0x41, 0x48,
+	0x41, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x56, 0x41, 0x52, 0x49, 0x41, 0x4e, 0x54, 0x5f, 0x53,
+	0x48, 0x4f, 0x52, 0x54, 0x5f, 0x44, 0x45, 0x43, 0x4b, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x56,
+	0x41, 0x52, 0x49, 0x41, 0x4e, 0x54, 0x5f, 0x52, 0x41, 0x5a, 0x5a, 0x10, 0x04, 0x12, 0x1a, 0x0a,
+	0x16, 0x56, 0x41, 0x52, 0x49, 0x41, 0x4e, 0x54, 0x5f, 0x44, 0x45, 0x55, 0x43, 0x45, 0x5f, 0x54,
+	0x4f, 0x5f, 0x53, 0x45, 0x56, 0x45, 0x4e, 0x10, 0x05, 0x2a, 0xc9, 0x01, 0x0a, 0x0d, 0x54, 0x69,
+	0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1f, 0x0a, 0x1b, 0x54,
+	0x49, 0x45, 0x5f, 0x42, 0x52, 0x45, 0x41, 0x4b, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18,
+	0x54, 0x49, 0x45, 0x5f, 0x42, 0x52, 0x45, 0x41, 0x4b, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f,
+	0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x54, 0x49,
+	0x45, 0x5f, 0x42, 0x52, 0x45, 0x41, 0x4b, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x50, 0x52,
+	0x49, 0x4d, 0x41, 0x52, 0x59, 0x5f, 0x52, 0x41, 0x4e, 0x4b, 0x10, 0x02, 0x12, 0x22, 0x0a, 0x1e,
+	0x54, 0x49, 0x45, 0x5f, 0x42, 0x52, 0x45, 0x41, 0x4b, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f,
+	0x53, 0x45, 0x43, 0x4f, 0x4e, 0x44, 0x41, 0x52, 0x59, 0x5f, 0x52, 0x41, 0x4e, 0x4b, 0x10, 0x03,
+	0x12, 0x1a, 0x0a, 0x16, 0x54, 0x49, 0x45, 0x5f, 0x42, 0x52, 0x45, 0x41, 0x4b, 0x5f, 0x4c, 0x45,
+	0x56, 0x45, 0x4c, 0x5f, 0x4b, 0x49, 0x43, 0x4b, 0x45, 0x52, 0x10, 0x04, 0x12, 0x17, 0x0a, 0x13,
+	0x54, 0x49, 0x45, 0x5f, 0x42, 0x52, 0x45, 0x41, 0x4b, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f,
+	0x54, 0x49, 0x45, 0x10, 0x05, 0x2a, 0x70, 0x0a, 0x09, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74,
+	0x6f, 0x72, 0x12, 0x13, 0x0a, 0x0f, 0x45, 0x53, 0x54, 0x49, 0x4d, 0x41, 0x54, 0x4f, 0x52, 0x5f,
+	0x4e, 0x41, 0x49, 0x56, 0x45, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x53, 0x54, 0x49, 0x4d,
+	0x41, 0x54, 0x4f, 0x52, 0x5f, 0x53, 0x54, 0x52, 0x41, 0x54, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x01, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x53, 0x54, 0x49, 0x4d, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x41,
+	0x4e, 0x54, 0x49, 0x54, 0x48, 0x45, 0x54, 0x49, 0x43, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x45,
+	0x53, 0x54, 0x49, 0x4d, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x51, 0x55, 0x41, 0x53, 0x49, 0x5f, 0x52,
+	0x41, 0x4e, 0x44, 0x4f, 0x4d, 0x10, 0x03, 0x32, 0xd7, 0x01, 0x0a, 0x0c, 0x50, 0x6f, 0x6b, 0x65,
+	0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x45, 0x76, 0x61, 0x6c,
+	0x75, 0x61, 0x74, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x12, 0x15, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x70, 0x61,
+	0x72, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x18, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d,
+	0x70, 0x61, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x14,
+	0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x12, 0x14, 0x2e, 0x70, 0x6f, 0x6b, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x69, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x6f, 0x6b,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x42, 0x14, 0x5a, 0x12, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x31, 0x3b,
+	0x70, 0x6f, 0x6b, 0x65, 0x72, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_v1_poker_proto_rawDescOnce sync.Once
+	file_proto_v1_poker_proto_rawDescData = file_proto_v1_poker_proto_rawDesc
+)
+
+func file_proto_v1_poker_proto_rawDescGZIP() []byte {
+	file_proto_v1_poker_proto_rawDescOnce.Do(func() {
+		file_proto_v1_poker_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_v1_poker_proto_rawDescData)
+	})
+	return file_proto_v1_poker_proto_rawDescData
+}
+
+var file_proto_v1_poker_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_proto_v1_poker_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_proto_v1_poker_proto_goTypes = []interface{}{
+	(Suit)(0),               // 0: poker.v1.Suit
+	(Rank)(0),               // 1: poker.v1.Rank
+	(HandCategory)(0),       // 2: poker.v1.HandCategory
+	(Variant)(0),            // 3: poker.v1.Variant
+	(TieBreakLevel)(0),      // 4: poker.v1.TieBreakLevel
+	(Estimator)(0),          // 5: poker.v1.Estimator
+	(*Card)(nil),            // 6: poker.v1.Card
+	(*HandRequest)(nil),     // 7: poker.v1.HandRequest
+	(*WildCards)(nil),       // 8: poker.v1.WildCards
+	(*Substitution)(nil),    // 9: poker.v1.Substitution
+	(*HandResponse)(nil),    // 10: poker.v1.HandResponse
+	(*Draw)(nil),            // 11: poker.v1.Draw
+	(*CompareRequest)(nil),  // 12: poker.v1.CompareRequest
+	(*CompareResponse)(nil), // 13: poker.v1.CompareResponse
+	(*TieBreak)(nil),        // 14: poker.v1.TieBreak
+	(*SimRequest)(nil),      // 15: poker.v1.SimRequest
+	(*SimResponse)(nil),     // 16: poker.v1.SimResponse
+}
+var file_proto_v1_poker_proto_depIdxs = []int32{
+	1,  // 0: poker.v1.Card.rank:type_name -> poker.v1.Rank
+	0,  // 1: poker.v1.Card.suit:type_name -> poker.v1.Suit
+	3,  // 2: poker.v1.HandRequest.variant:type_name -> poker.v1.Variant
+	6,  // 3: poker.v1.HandRequest.hole_cards:type_name -> poker.v1.Card
+	6,  // 4: poker.v1.HandRequest.community_cards:type_name -> poker.v1.Card
+	8,  // 5: poker.v1.HandRequest.wild:type_name -> poker.v1.WildCards
+	1,  // 6: poker.v1.WildCards.wild_rank:type_name -> poker.v1.Rank
+	6,  // 7: poker.v1.Substitution.wild_card:type_name -> poker.v1.Card
+	6,  // 8: poker.v1.Substitution.plays_as:type_name -> poker.v1.Card
+	2,  // 9: poker.v1.HandResponse.category:type_name -> poker.v1.HandCategory
+	6,  // 10: poker.v1.HandResponse.best_cards:type_name -> poker.v1.Card
+	11, // 11: poker.v1.HandResponse.draws:type_name -> poker.v1.Draw
+	9,  // 12: poker.v1.HandResponse.substitutions:type_name -> poker.v1.Substitution
+	6,  // 13: poker.v1.Draw.outs:type_name -> poker.v1.Card
+	7,  // 14: poker.v1.CompareRequest.hand1:type_name -> poker.v1.HandRequest
+	7,  // 15: poker.v1.CompareRequest.hand2:type_name -> poker.v1.HandRequest
+	10, // 16: poker.v1.CompareResponse.hand1_result:type_name -> poker.v1.HandResponse
+	10, // 17: poker.v1.CompareResponse.hand2_result:type_name -> poker.v1.HandResponse
+	14, // 18: poker.v1.CompareResponse.tie_break:type_name -> poker.v1.TieBreak
+	4,  // 19: poker.v1.TieBreak.level:type_name -> poker.v1.TieBreakLevel
+	6,  // 20: poker.v1.TieBreak.hand1_cards:type_name -> poker.v1.Card
+	6,  // 21: poker.v1.TieBreak.hand2_cards:type_name -> poker.v1.Card
+	3,  // 22: poker.v1.SimRequest.variant:type_name -> poker.v1.Variant
+	6,  // 23: poker.v1.SimRequest.hole_cards:type_name -> poker.v1.Card
+	6,  // 24: poker.v1.SimRequest.community_cards:type_name -> poker.v1.Card
+	5,  // 25: poker.v1.SimRequest.estimator:type_name -> poker.v1.Estimator
+	8,  // 26: poker.v1.SimRequest.wild:type_name -> poker.v1.WildCards
+	5,  // 27: poker.v1.SimResponse.estimator_used:type_name -> poker.v1.Estimator
+	7,  // 28: poker.v1.PokerService.EvaluateHand:input_type -> poker.v1.HandRequest
+	12, // 29: poker.v1.PokerService.CompareHands:input_type -> poker.v1.CompareRequest
+	15, // 30: poker.v1.PokerService.CalculateProbability:input_type -> poker.v1.SimRequest
+	10, // 31: poker.v1.PokerService.EvaluateHand:output_type -> poker.v1.HandResponse
+	13, // 32: poker.v1.PokerService.CompareHands:output_type -> poker.v1.CompareResponse
+	16, // 33: poker.v1.PokerService.CalculateProbability:output_type -> poker.v1.SimResponse
+	31, // [31:34] is the sub-list for method output_type
+	28, // [28:31] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
+}
+
+func init() { file_proto_v1_poker_proto_init() }
+func file_proto_v1_poker_proto_init() {
+	if File_proto_v1_poker_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_v1_poker_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Card); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_poker_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HandRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_poker_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WildCards); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_poker_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Substitution); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_poker_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HandResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_poker_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Draw); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_poker_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompareRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_poker_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompareResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_poker_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TieBreak); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_poker_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v1_poker_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_v1_poker_proto_rawDesc,
+			NumEnums:      6,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_v1_poker_proto_goTypes,
+		DependencyIndexes: file_proto_v1_poker_proto_depIdxs,
+		EnumInfos:         file_proto_v1_poker_proto_enumTypes,
+		MessageInfos:      file_proto_v1_poker_proto_msgTypes,
+	}.Build()
+	File_proto_v1_poker_proto = out.File
+	file_proto_v1_poker_proto_rawDesc = nil
+	file_proto_v1_poker_proto_goTypes = nil
+	file_proto_v1_poker_proto_depIdxs = nil
+}
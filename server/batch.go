@@ -0,0 +1,165 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"runtime"
+	"sync"
+
+	pb "github.com/mispice/Poker-dist-assignment/proto"
+)
+
+// maxBatchWorkers caps the workers a batch can ask for
+const maxBatchWorkers = 64
+
+// batchJob is an item on its way through the pool; done receives its result
+type batchJob struct {
+	index int64
+	item  *pb.BatchItem
+	done  chan *pb.BatchResult
+}
+
+// EvaluateBatch evaluates and compares many hands in one call
+func (s *PokerServer) EvaluateBatch(ctx context.Context, req *pb.BatchRequest) (*pb.BatchResponse, error) {
+	if req.Workers < 0 {
+		return nil, fmt.Errorf("workers cannot be negative: %d", req.Workers)
+	}
+
+	next := 0
+	read := func() (*pb.BatchItem, error) {
+		if next == len(req.Items) {
+			return nil, io.EOF
+		}
+		next++
+		return req.Items[next-1], nil
+	}
+
+	resp := &pb.BatchResponse{Results: make([]*pb.BatchResult, 0, len(req.Items))}
+	collect := func(result *pb.BatchResult) error {
+		if result.Error != "" {
+			resp.Failed++
+		}
+		resp.Results = append(resp.Results, result)
+		return nil
+	}
+
+	if err := runBatch(ctx, int(req.Workers), read, s.processBatchItem, collect); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// EvaluateStream evaluates and compares hands as they arrive, sending each
+// result back in the order its item was received
+func (s *PokerServer) EvaluateStream(stream pb.PokerService_EvaluateStreamServer) error {
+	return runBatch(stream.Context(), 0, stream.Recv, s.processBatchItem, stream.Send)
+}
+
+// processBatchItem runs one item, reporting a failure in the result rather
+// than failing the batch
+func (s *PokerServer) processBatchItem(ctx context.Context, item *pb.BatchItem) *pb.BatchResult {
+	result := &pb.BatchResult{}
+	var err error
+
+	switch req := item.GetItem().(type) {
+	case *pb.BatchItem_Evaluate:
+		var resp *pb.HandResponse
+		if resp, err = s.EvaluateHand(ctx, req.Evaluate); err == nil {
+			result.Result = &pb.BatchResult_Evaluate{Evaluate: resp}
+		}
+	case *pb.BatchItem_Compare:
+		var resp *pb.CompareResponse
+		if resp, err = s.CompareHands(ctx, req.Compare); err == nil {
+			result.Result = &pb.BatchResult_Compare{Compare: resp}
+		}
+	default:
+		err = fmt.Errorf("batch item has neither evaluate nor compare")
+	}
+
+	if err != nil {
+		result.Error = err.Error()
+	}
+	return result
+}
+
+// runBatch reads items until io.EOF, processes them on a pool of workers and
+// emits the results in the order the items were read. At most one item per
+// worker waits to be emitted, so a slow reader of results holds back reading.
+func runBatch(ctx context.Context, workers int,
+	read func() (*pb.BatchItem, error),
+	process func(context.Context, *pb.BatchItem) *pb.BatchResult,
+	emit func(*pb.BatchResult) error) error {
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	if workers > maxBatchWorkers {
+		workers = maxBatchWorkers
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	work := make(chan *batchJob)
+	pending := make(chan *batchJob, workers) // Jobs in arrival order
+	readErr := make(chan error, 1)
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range work {
+				result := process(ctx, job.item)
+				result.Index = job.index
+				job.done <- result
+			}
+		}()
+	}
+
+	go func() {
+		defer close(pending)
+		defer close(work)
+		for index := int64(0); ; index++ {
+			item, err := read()
+			if err == io.EOF {
+				return
+			}
+			if err != nil {
+				readErr <- err
+				return
+			}
+
+			job := &batchJob{index: index, item: item, done: make(chan *pb.BatchResult, 1)}
+			select {
+			case pending <- job:
+			case <-ctx.Done():
+				return
+			}
+			select {
+			case work <- job:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	for job := range pending {
+		select {
+		case result := <-job.done:
+			if err := emit(result); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	wg.Wait()
+
+	select {
+	case err := <-readErr:
+		return err
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	pb "github.com/mispice/Poker-dist-assignment/proto"
+)
+
+// sliceReader returns a read function that yields items then io.EOF
+func sliceReader(items []*pb.BatchItem) func() (*pb.BatchItem, error) {
+	next := 0
+	return func() (*pb.BatchItem, error) {
+		if next == len(items) {
+			return nil, io.EOF
+		}
+		next++
+		return items[next-1], nil
+	}
+}
+
+func TestRunBatchKeepsInputOrder(t *testing.T) {
+	const n = 20
+	items := make([]*pb.BatchItem, n)
+	position := map[*pb.BatchItem]int{}
+	for i := range items {
+		items[i] = &pb.BatchItem{}
+		position[items[i]] = i
+	}
+
+	// Earlier items take longer, so workers finish them last
+	produced := make([]*pb.BatchResult, n)
+	process := func(_ context.Context, item *pb.BatchItem) *pb.BatchResult {
+		i := position[item]
+		time.Sleep(time.Duration(n-i) * time.Millisecond)
+		produced[i] = &pb.BatchResult{}
+		return produced[i]
+	}
+
+	var results []*pb.BatchResult
+	emit := func(result *pb.BatchResult) error {
+		results = append(results, result)
+		return nil
+	}
+
+	if err := runBatch(context.Background(), 8, sliceReader(items), process, emit); err != nil {
+		t.Fatalf("runBatch: %v", err)
+	}
+	if len(results) != n {
+		t.Fatalf("got %d results, want %d", len(results), n)
+	}
+	for i, result := range results {
+		if result != produced[i] {
+			t.Errorf("result %d was produced for a different item", i)
+		}
+		if result.Index != int64(i) {
+			t.Errorf("result %d has index %d", i, result.Index)
+		}
+	}
+}
+
+func TestRunBatchStopsOnEmitError(t *testing.T) {
+	items := make([]*pb.BatchItem, 50)
+	for i := range items {
+		items[i] = &pb.BatchItem{}
+	}
+	process := func(context.Context, *pb.BatchItem) *pb.BatchResult {
+		return &pb.BatchResult{}
+	}
+
+	sendErr := errors.New("client went away")
+	emitted := 0
+	emit := func(*pb.BatchResult) error {
+		emitted++
+		if emitted == 3 {
+			return sendErr
+		}
+		return nil
+	}
+
+	if err := runBatch(context.Background(), 4, sliceReader(items), process, emit); !errors.Is(err, sendErr) {
+		t.Fatalf("runBatch error = %v, want %v", err, sendErr)
+	}
+	if emitted != 3 {
+		t.Errorf("emit called %d times, want 3", emitted)
+	}
+}
+
+func TestEvaluateBatchReportsItemFailures(t *testing.T) {
+	req := &pb.BatchRequest{Items: []*pb.BatchItem{
+		{Item: &pb.BatchItem_Evaluate{Evaluate: &pb.HandRequest{
+			HoleCards:      []string{"HA", "SK"},
+			CommunityCards: []string{"D2", "C7", "H9"},
+		}}},
+		{Item: &pb.BatchItem_Evaluate{Evaluate: &pb.HandRequest{
+			HoleCards: []string{"HA", "HA"},
+		}}},
+		{},
+	}}
+
+	resp, err := NewPokerServer().EvaluateBatch(context.Background(), req)
+	if err != nil {
+		t.Fatalf("EvaluateBatch: %v", err)
+	}
+	if len(resp.Results) != 3 {
+		t.Fatalf("got %d results, want 3", len(resp.Results))
+	}
+	if resp.Failed != 2 {
+		t.Errorf("Failed = %d, want 2", resp.Failed)
+	}
+	if resp.Results[0].Error != "" || resp.Results[0].GetEvaluate() == nil {
+		t.Errorf("item 0 should succeed, got error %q", resp.Results[0].Error)
+	}
+	for i := 1; i < 3; i++ {
+		if resp.Results[i].Error == "" {
+			t.Errorf("item %d should fail", i)
+		}
+		if resp.Results[i].Index != int64(i) {
+			t.Errorf("item %d has index %d", i, resp.Results[i].Index)
+		}
+	}
+}
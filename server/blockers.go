@@ -0,0 +1,103 @@
+package main
+
+import "fmt"
+
+// BlockerGroup counts the combos of one hand category in an opponent range
+// before and after removing the cards hero holds
+type BlockerGroup struct {
+	Rank            HandRank
+	CombosBefore    float64 // Weighted by the range
+	CombosAfter     float64
+	NutHand         EvaluatedHand // Best hand of this category in the range
+	NutCombosBefore float64
+	NutCombosAfter  float64
+}
+
+// BlockerAnalysis summarises how hero's cards shrink an opponent range
+type BlockerAnalysis struct {
+	Groups      []BlockerGroup // Best category first, categories absent from the range omitted
+	TotalBefore float64
+	TotalAfter  float64
+}
+
+// AnalyzeBlockers groups an opponent range by the hand category each combo
+// makes on the board, and counts how many combos hero's hole cards remove.
+// Combos colliding with the board are never counted.
+func AnalyzeBlockers(holeCards []Card, communityCards []Card, opponentRange Range) (BlockerAnalysis, error) {
+	if len(holeCards) != 2 {
+		return BlockerAnalysis{}, fmt.Errorf("need exactly 2 hole cards, got %d", len(holeCards))
+	}
+	if len(communityCards) != 0 && (len(communityCards) < 3 || len(communityCards) > 5) {
+		return BlockerAnalysis{}, fmt.Errorf("need 0 or 3 to 5 community cards, got %d", len(communityCards))
+	}
+	if err := checkDistinct(holeCards, communityCards); err != nil {
+		return BlockerAnalysis{}, err
+	}
+
+	heroCards := make(map[Card]bool, len(holeCards))
+	for _, card := range holeCards {
+		heroCards[card] = true
+	}
+
+	groups := make(map[HandRank]*BlockerGroup)
+	var analysis BlockerAnalysis
+	for _, combo := range removeBlocked(opponentRange.Combos(), communityCards) {
+		hand := comboHand(combo, communityCards)
+		blocked := heroCards[combo[0]] || heroCards[combo[1]]
+		weight := opponentRange.Weight(combo)
+
+		group, ok := groups[hand.Rank]
+		if !ok {
+			group = &BlockerGroup{Rank: hand.Rank}
+			groups[hand.Rank] = group
+		}
+
+		// A better hand in the category resets the nut counts
+		if hand.RankValue > group.NutHand.RankValue || group.CombosBefore == 0 {
+			group.NutHand = hand
+			group.NutCombosBefore, group.NutCombosAfter = 0, 0
+		}
+		if hand.RankValue == group.NutHand.RankValue {
+			group.NutCombosBefore += weight
+			if !blocked {
+				group.NutCombosAfter += weight
+			}
+		}
+
+		group.CombosBefore += weight
+		analysis.TotalBefore += weight
+		if !blocked {
+			group.CombosAfter += weight
+			analysis.TotalAfter += weight
+		}
+	}
+
+	for rank := StraightFlush; rank >= HighCard; rank-- {
+		if group, ok := groups[rank]; ok {
+			analysis.Groups = append(analysis.Groups, *group)
+		}
+	}
+	return analysis, nil
+}
+
+// comboHand evaluates a two-card holding on a board. Preflop only pairs can
+// be told apart from high cards.
+func comboHand(combo Combo, communityCards []Card) EvaluatedHand {
+	if len(communityCards) > 0 {
+		return EvaluateBestHand(append([]Card{combo[0], combo[1]}, communityCards...))
+	}
+
+	high, low := combo[0], combo[1]
+	if low.Rank > high.Rank {
+		high, low = low, high
+	}
+	cards := []Card{high, low}
+	if high.Rank == low.Rank {
+		return EvaluatedHand{
+			Rank:      OnePair,
+			Cards:     cards,
+			RankValue: int32(OnePair)*10000000 + int32(high.Rank)*100000,
+		}
+	}
+	return EvaluatedHand{Rank: HighCard, Cards: cards, RankValue: highCardValue(cards)}
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"sort"
+	"sync"
+)
+
+// totalHandClasses is the number of distinct 5-card poker hands
+const totalHandClasses = 7462
+
+// Number of 5-card and 7-card hands making each category, by HandRank
+var (
+	fiveCardCounts  = [9]float64{1302540, 1098240, 123552, 54912, 10200, 5108, 3744, 624, 40}
+	sevenCardCounts = [9]float64{23294460, 58627800, 31433400, 6461620, 6180020, 4047644, 3473184, 224848, 41584}
+)
+
+const (
+	totalFiveCardHands  = 2598960
+	totalSevenCardHands = 133784560
+)
+
+// handClassTable lists every distinct 5-card hand value from best to worst,
+// with the number of 5-card hands that share it
+var handClassTable struct {
+	once   sync.Once
+	values []int32
+	counts []int
+	worse  []int // 5-card hands strictly worse than each class
+}
+
+// buildHandClasses enumerates each multiset of five ranks once, plus the
+// suited version when the ranks are all different
+func buildHandClasses() {
+	suits := []string{"H", "D", "C", "S"}
+	counts := make(map[int32]int)
+
+	var ranks [5]int
+	var choose func(pos, maxRank int)
+	choose = func(pos, maxRank int) {
+		if pos == 5 {
+			// Repeated ranks sit next to each other, so cycling suits keeps cards distinct
+			cards := make([]Card, 5)
+			for i, rank := range ranks {
+				cards[i] = Card{Rank: rank, Suit: suits[i%4]}
+			}
+
+			multiplicity := make(map[int]int)
+			for _, rank := range ranks {
+				multiplicity[rank]++
+			}
+			hands := 1
+			for _, m := range multiplicity {
+				hands *= int(binomial(4, m))
+			}
+
+			if len(multiplicity) == 5 {
+				counts[handValue(cards)] += hands - 4
+				for i := range cards {
+					cards[i].Suit = "H"
+				}
+				counts[handValue(cards)] += 4
+			} else {
+				counts[handValue(cards)] += hands
+			}
+			return
+		}
+		for rank := maxRank; rank >= 2; rank-- {
+			repeats := 0
+			for _, r := range ranks[:pos] {
+				if r == rank {
+					repeats++
+				}
+			}
+			if repeats < 4 {
+				ranks[pos] = rank
+				choose(pos+1, rank)
+			}
+		}
+	}
+	choose(0, 14)
+
+	table := &handClassTable
+	for value := range counts {
+		table.values = append(table.values, value)
+	}
+	sort.Slice(table.values, func(i, j int) bool { return table.values[i] > table.values[j] })
+
+	table.counts = make([]int, len(table.values))
+	table.worse = make([]int, len(table.values))
+	worse := 0
+	for i := len(table.values) - 1; i >= 0; i-- {
+		table.counts[i] = counts[table.values[i]]
+		table.worse[i] = worse
+		worse += table.counts[i]
+	}
+}
+
+// HandClass returns the equivalence class of a hand value, from 1 for a
+// royal flush to 7462 for 7-5-4-3-2 offsuit, or 0 for an unknown value
+func HandClass(rankValue int32) int {
+	handClassTable.once.Do(buildHandClasses)
+	values := handClassTable.values
+	i := sort.Search(len(values), func(i int) bool { return values[i] <= rankValue })
+	if i == len(values) || values[i] != rankValue {
+		return 0
+	}
+	return i + 1
+}
+
+// FiveCardPercentile returns the percentage of 5-card hands a hand value
+// beats, counting ties as half
+func FiveCardPercentile(rankValue int32) float64 {
+	class := HandClass(rankValue)
+	if class == 0 {
+		return 0
+	}
+	table := &handClassTable
+	beaten := float64(table.worse[class-1]) + float64(table.counts[class-1]-1)/2
+	return 100 * beaten / (totalFiveCardHands - 1)
+}
+
+// BoardPercentile returns the percentage of opponent holdings on the board
+// that hero's hand beats, counting ties as half
+func BoardPercentile(holeCards []Card, communityCards []Card) float64 {
+	known := append(append([]Card{}, holeCards...), communityCards...)
+	heroValue := handValue(known)
+
+	opponent := append([]Card{{}, {}}, communityCards...)
+	beaten, total := 0.0, 0
+	for _, combo := range removeBlocked(allCombos(), known) {
+		opponent[0], opponent[1] = combo[0], combo[1]
+		switch compareValues(heroValue, handValue(opponent)) {
+		case handAhead:
+			beaten++
+		case handTied:
+			beaten += 0.5
+		}
+		total++
+	}
+	return 100 * beaten / float64(total)
+}
+
+// CategoryProbability returns the chance of a category in 5-card and 7-card
+// hands, which is zero for Five of a Kind without wild cards
+func CategoryProbability(rank HandRank) (fiveCard, sevenCard float64) {
+	if int(rank) >= len(fiveCardCounts) {
+		return 0, 0
+	}
+	return fiveCardCounts[rank] / totalFiveCardHands, sevenCardCounts[rank] / totalSevenCardHands
+}
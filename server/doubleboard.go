@@ -0,0 +1,166 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+)
+
+// DoubleBoardPayout is what one player wins over both boards
+type DoubleBoardPayout struct {
+	Seat   int
+	Payout int64
+	Scoop  bool // Won both halves alone
+}
+
+// DoubleBoardResult is a showdown on two boards, each for half the pot
+type DoubleBoardResult struct {
+	Top     ShowdownResult
+	Bottom  ShowdownResult
+	Payouts []DoubleBoardPayout // In the order the players were given
+}
+
+// DoubleBoardEquity is one player's share of a double-board pot
+type DoubleBoardEquity struct {
+	Seat   int
+	Equity float64 // Share of the whole pot won on average
+	Top    float64 // Share of the top board's half won on average
+	Bottom float64
+	Scoop  float64 // Chance of winning both halves alone
+}
+
+// DoubleBoardShowdown ranks every player against two boards, as in a bomb
+// pot, and gives half the pot to the best hand on each. When the pot does
+// not halve evenly the top board plays for the odd chip.
+func DoubleBoardShowdown(players []ShowdownPlayer, top []Card, bottom []Card, pot int64, button int) (DoubleBoardResult, error) {
+	if err := validateDoubleBoard(players, top, bottom, nil); err != nil {
+		return DoubleBoardResult{}, err
+	}
+	if pot < 0 {
+		return DoubleBoardResult{}, fmt.Errorf("pot cannot be negative: %d", pot)
+	}
+
+	var result DoubleBoardResult
+	var err error
+	if result.Top, err = Showdown(players, top, pot-pot/2, button); err != nil {
+		return DoubleBoardResult{}, fmt.Errorf("top board: %v", err)
+	}
+	if result.Bottom, err = Showdown(players, bottom, pot/2, button); err != nil {
+		return DoubleBoardResult{}, fmt.Errorf("bottom board: %v", err)
+	}
+
+	payouts := make(map[int]int64)
+	for _, board := range []ShowdownResult{result.Top, result.Bottom} {
+		for _, entry := range board.Entries {
+			payouts[entry.Seat] += entry.Payout
+		}
+	}
+	scooper := -1
+	if len(result.Top.Winners) == 1 && len(result.Bottom.Winners) == 1 && result.Top.Winners[0] == result.Bottom.Winners[0] {
+		scooper = result.Top.Winners[0]
+	}
+	for _, player := range players {
+		result.Payouts = append(result.Payouts, DoubleBoardPayout{
+			Seat:   player.Seat,
+			Payout: payouts[player.Seat],
+			Scoop:  player.Seat == scooper,
+		})
+	}
+	return result, nil
+}
+
+// SimulateDoubleBoard estimates each player's share of a double-board pot by
+// running out both boards together from one deck, so no card can fall on
+// both. Boards may be empty, as in a bomb pot dealt straight to the flop.
+func SimulateDoubleBoard(players []ShowdownPlayer, top []Card, bottom []Card, dead []Card, numSimulations int) ([]DoubleBoardEquity, error) {
+	if err := validateDoubleBoard(players, top, bottom, dead); err != nil {
+		return nil, err
+	}
+
+	known := append(append(append([]Card{}, top...), bottom...), dead...)
+	for _, player := range players {
+		known = append(known, player.HoleCards...)
+	}
+	deck := remainingDeck(known)
+	topMissing, bottomMissing := 5-len(top), 5-len(bottom)
+	if topMissing+bottomMissing > len(deck) {
+		return nil, fmt.Errorf("need %d cards to run out both boards, %d remain", topMissing+bottomMissing, len(deck))
+	}
+
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	equities := make([]DoubleBoardEquity, len(players))
+	values := make([]int32, len(players))
+	for i := 0; i < numSimulations; i++ {
+		dealPrefix(rng, deck, 0, topMissing+bottomMissing)
+		boards := [2][]Card{
+			append(append([]Card{}, top...), deck[:topMissing]...),
+			append(append([]Card{}, bottom...), deck[topMissing:topMissing+bottomMissing]...),
+		}
+
+		soleWinner := [2]int{-1, -1}
+		for b, board := range boards {
+			best, winners := int32(-1), 0
+			for p, player := range players {
+				values[p] = EvaluateBestHand(append(append([]Card{}, player.HoleCards...), board...)).RankValue
+				if values[p] > best {
+					best, winners = values[p], 0
+				}
+				if values[p] == best {
+					winners++
+				}
+			}
+			for p := range players {
+				if values[p] != best {
+					continue
+				}
+				share := 1 / float64(winners)
+				if b == 0 {
+					equities[p].Top += share
+				} else {
+					equities[p].Bottom += share
+				}
+				if winners == 1 {
+					soleWinner[b] = p
+				}
+			}
+		}
+		if soleWinner[0] >= 0 && soleWinner[0] == soleWinner[1] {
+			equities[soleWinner[0]].Scoop++
+		}
+	}
+
+	n := float64(numSimulations)
+	for p, player := range players {
+		equities[p].Seat = player.Seat
+		equities[p].Top /= n
+		equities[p].Bottom /= n
+		equities[p].Scoop /= n
+		equities[p].Equity = (equities[p].Top + equities[p].Bottom) / 2
+	}
+	return equities, nil
+}
+
+// validateDoubleBoard checks the players and both boards share no card, and
+// that every player has two hole cards in a seat of their own
+func validateDoubleBoard(players []ShowdownPlayer, top []Card, bottom []Card, dead []Card) error {
+	if len(players) < 2 {
+		return fmt.Errorf("need at least 2 players, got %d", len(players))
+	}
+	if len(top) > 5 || len(bottom) > 5 {
+		return fmt.Errorf("a board has at most 5 cards, got %d and %d", len(top), len(bottom))
+	}
+
+	seats := make(map[int]bool)
+	dealt := [][]Card{top, bottom, dead}
+	for _, player := range players {
+		if seats[player.Seat] {
+			return fmt.Errorf("seat %d appears twice", player.Seat)
+		}
+		seats[player.Seat] = true
+		if len(player.HoleCards) != 2 {
+			return fmt.Errorf("seat %d needs exactly 2 hole cards, got %d", player.Seat, len(player.HoleCards))
+		}
+		dealt = append(dealt, player.HoleCards)
+	}
+	return checkDistinct(dealt...)
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func doubleBoardPlayers(t *testing.T) []ShowdownPlayer {
+	t.Helper()
+	return []ShowdownPlayer{
+		{Seat: 1, HoleCards: mustParseHand(t, "Ah Ad")},
+		{Seat: 2, HoleCards: mustParseHand(t, "Kh Kd")},
+	}
+}
+
+func TestDoubleBoardShowdown(t *testing.T) {
+	top := "2c 7s 9d Tc 3h" // Aces hold
+	tests := []struct {
+		name   string
+		bottom string
+		pot    int64
+		want   []DoubleBoardPayout
+	}{
+		{"odd chip to the top board", "Kc 4s 8d Jc 5h", 101, []DoubleBoardPayout{
+			{Seat: 1, Payout: 51}, {Seat: 2, Payout: 50},
+		}},
+		{"scoop", "6c 4d 8s Jd 5c", 101, []DoubleBoardPayout{
+			{Seat: 1, Payout: 101, Scoop: true}, {Seat: 2, Payout: 0},
+		}},
+		{"split bottom is no scoop", "Ts Jd Qc Kc Ac", 101, []DoubleBoardPayout{
+			{Seat: 1, Payout: 76}, {Seat: 2, Payout: 25},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := DoubleBoardShowdown(doubleBoardPlayers(t), mustParseHand(t, top), mustParseHand(t, tt.bottom), tt.pot, 0)
+			if err != nil {
+				t.Fatalf("DoubleBoardShowdown: %v", err)
+			}
+			if len(result.Payouts) != len(tt.want) {
+				t.Fatalf("got %d payouts, want %d", len(result.Payouts), len(tt.want))
+			}
+			for i, want := range tt.want {
+				if result.Payouts[i] != want {
+					t.Errorf("payout %d = %+v, want %+v", i, result.Payouts[i], want)
+				}
+			}
+		})
+	}
+}
+
+func TestSimulateDoubleBoard(t *testing.T) {
+	players := doubleBoardPlayers(t)
+
+	// With both boards dealt out every run is the same
+	equities, err := SimulateDoubleBoard(players, mustParseHand(t, "2c 7s 9d Tc 3h"), mustParseHand(t, "6c 4d 8s Jd 5c"), nil, 50)
+	if err != nil {
+		t.Fatalf("SimulateDoubleBoard: %v", err)
+	}
+	if aces := equities[0]; aces.Equity != 1 || aces.Scoop != 1 {
+		t.Errorf("aces = %+v, want a certain scoop", aces)
+	}
+	equities, err = SimulateDoubleBoard(players, mustParseHand(t, "2c 7s 9d Tc 3h"), mustParseHand(t, "Kc 4s 8d Jc 5h"), nil, 50)
+	if err != nil {
+		t.Fatalf("SimulateDoubleBoard: %v", err)
+	}
+	if aces, kings := equities[0], equities[1]; aces.Top != 1 || kings.Bottom != 1 || aces.Scoop != 0 || kings.Scoop != 0 {
+		t.Errorf("got %+v and %+v, want one board each and no scoop", aces, kings)
+	}
+
+	// From empty boards the shares add up and a scoop needs both halves
+	equities, err = SimulateDoubleBoard(players, nil, nil, nil, 5000)
+	if err != nil {
+		t.Fatalf("SimulateDoubleBoard: %v", err)
+	}
+	if total := equities[0].Equity + equities[1].Equity; math.Abs(total-1) > 1e-9 {
+		t.Errorf("equities sum to %v, want 1", total)
+	}
+	for _, equity := range equities {
+		if equity.Scoop > math.Min(equity.Top, equity.Bottom) {
+			t.Errorf("seat %d scoops %.3f but wins top %.3f and bottom %.3f", equity.Seat, equity.Scoop, equity.Top, equity.Bottom)
+		}
+	}
+	if equities[0].Scoop < 0.5 {
+		t.Errorf("aces scoop %.3f against kings, want well over half", equities[0].Scoop)
+	}
+}
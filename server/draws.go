@@ -0,0 +1,189 @@
+package main
+
+import "sort"
+
+// Draw names reported alongside the made hand
+const (
+	FlushDraw            = "Flush Draw"
+	OpenEndedStraight    = "Open-Ended Straight Draw"
+	DoubleGutshot        = "Double Gutshot"
+	Gutshot              = "Gutshot"
+	BackdoorFlushDraw    = "Backdoor Flush Draw"
+	BackdoorStraightDraw = "Backdoor Straight Draw"
+	Overcards            = "Overcards"
+)
+
+// Draw is an unfinished hand together with the cards that complete it
+type Draw struct {
+	Name string
+	Outs []Card
+}
+
+// FindDraws reports the draws hero holds while community cards are still to
+// come. Draws must use at least one hole card, and draws to a hand hero has
+// already made are skipped.
+func FindDraws(holeCards []Card, communityCards []Card, bestHand EvaluatedHand) []Draw {
+	if len(communityCards) >= 5 || len(holeCards) == 0 {
+		return nil
+	}
+
+	allCards := append(append([]Card{}, holeCards...), communityCards...)
+	unseen := remainingDeck(allCards)
+	flop := len(communityCards) == 3
+	draws := []Draw{}
+
+	// Flush draws: four of a suit, or three on the flop for a backdoor draw
+	if bestHand.Rank < Flush {
+		suitCounts := make(map[string]int)
+		for _, card := range allCards {
+			suitCounts[card.Suit]++
+		}
+		for _, suit := range []string{"H", "D", "C", "S"} {
+			if !holdsSuit(holeCards, suit) {
+				continue
+			}
+			outs := cardsWhere(unseen, func(c Card) bool { return c.Suit == suit })
+			if suitCounts[suit] == 4 {
+				draws = append(draws, Draw{Name: FlushDraw, Outs: outs})
+			} else if suitCounts[suit] == 3 && flop {
+				draws = append(draws, Draw{Name: BackdoorFlushDraw, Outs: outs})
+			}
+		}
+	}
+
+	// Straight draws: ranks that fill a four-card straight window
+	if bestHand.Rank < Straight {
+		oneAway := straightDrawRanks(holeCards, allCards, 4)
+		if len(oneAway) > 0 {
+			outs := cardsWhere(unseen, func(c Card) bool { return oneAway[c.Rank] })
+			name := Gutshot
+			if len(oneAway) >= 2 {
+				name = DoubleGutshot
+				if hasOpenEndedRun(allCards, oneAway) {
+					name = OpenEndedStraight
+				}
+			}
+			draws = append(draws, Draw{Name: name, Outs: outs})
+		} else if flop {
+			twoAway := straightDrawRanks(holeCards, allCards, 3)
+			if len(twoAway) > 0 {
+				outs := cardsWhere(unseen, func(c Card) bool { return twoAway[c.Rank] })
+				draws = append(draws, Draw{Name: BackdoorStraightDraw, Outs: outs})
+			}
+		}
+	}
+
+	// Overcards: unpaired hole cards above every community card
+	if bestHand.Rank == HighCard && len(communityCards) > 0 {
+		boardHigh := 0
+		for _, card := range communityCards {
+			if card.Rank > boardHigh {
+				boardHigh = card.Rank
+			}
+		}
+		overRanks := make(map[int]bool)
+		for _, card := range holeCards {
+			if card.Rank > boardHigh {
+				overRanks[card.Rank] = true
+			}
+		}
+		if len(overRanks) == len(holeCards) {
+			outs := cardsWhere(unseen, func(c Card) bool { return overRanks[c.Rank] })
+			draws = append(draws, Draw{Name: Overcards, Outs: outs})
+		}
+	}
+
+	return draws
+}
+
+// straightWindows lists the five ranks of every straight, wheel first
+var straightWindows = func() [][]int {
+	windows := [][]int{{14, 2, 3, 4, 5}}
+	for low := 2; low <= 10; low++ {
+		windows = append(windows, []int{low, low + 1, low + 2, low + 3, low + 4})
+	}
+	return windows
+}()
+
+// straightDrawRanks returns the missing ranks of every straight window in
+// which the cards hold exactly `have` ranks, including at least one hole card
+func straightDrawRanks(holeCards []Card, allCards []Card, have int) map[int]bool {
+	present := make(map[int]bool)
+	for _, card := range allCards {
+		present[card.Rank] = true
+	}
+	holeRanks := make(map[int]bool)
+	for _, card := range holeCards {
+		holeRanks[card.Rank] = true
+	}
+
+	missing := make(map[int]bool)
+	for _, window := range straightWindows {
+		count := 0
+		usesHole := false
+		for _, rank := range window {
+			if present[rank] {
+				count++
+				usesHole = usesHole || holeRanks[rank]
+			}
+		}
+		if count != have || !usesHole {
+			continue
+		}
+		for _, rank := range window {
+			if !present[rank] {
+				missing[rank] = true
+			}
+		}
+	}
+	return missing
+}
+
+// hasOpenEndedRun reports whether four consecutive ranks are held with both
+// ends among the completing ranks
+func hasOpenEndedRun(allCards []Card, completing map[int]bool) bool {
+	present := make(map[int]bool)
+	for _, card := range allCards {
+		present[card.Rank] = true
+		if card.Rank == 14 {
+			present[1] = true // Ace plays low in the wheel
+		}
+	}
+	for low := 1; low <= 11; low++ {
+		if !present[low] || !present[low+1] || !present[low+2] || !present[low+3] {
+			continue
+		}
+		below, above := low-1, low+4
+		if below == 1 {
+			below = 14
+		}
+		if completing[below] && completing[above] {
+			return true
+		}
+	}
+	return false
+}
+
+// holdsSuit reports whether any of the cards has the given suit
+func holdsSuit(cards []Card, suit string) bool {
+	for _, card := range cards {
+		if card.Suit == suit {
+			return true
+		}
+	}
+	return false
+}
+
+// cardsWhere returns the cards matching keep, highest rank first
+func cardsWhere(cards []Card, keep func(Card) bool) []Card {
+	matched := []Card{}
+	for _, card := range cards {
+		if keep(card) {
+			matched = append(matched, card)
+		}
+	}
+	sort.SliceStable(matched, func(i, j int) bool {
+		return matched[i].Rank > matched[j].Rank
+	})
+	return matched
+}
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"math/rand"
 	"sort"
-	"strings"
 	"time"
 )
 
@@ -27,6 +26,7 @@ const (
 	FullHouse
 	FourOfAKind
 	StraightFlush
+	FiveOfAKind // Only with wild cards
 )
 
 var handNames = map[HandRank]string{
@@ -39,75 +39,38 @@ var handNames = map[HandRank]string{
 	FullHouse:     "Full House",
 	FourOfAKind:   "Four of a Kind",
 	StraightFlush: "Straight Flush",
+	FiveOfAKind:   "Five of a Kind",
 }
 
-// ParseCard converts string like "HA", "D10", "SK" to Card
+// ParseCard converts a card string to Card in any supported notation:
+// suit-first "HA" or "D10", rank-first "Ah" or "Td", or "A♥"
 func ParseCard(s string) (Card, error) {
-	s = strings.TrimSpace(strings.ToUpper(s))
-	if len(s) < 2 {
-		return Card{}, fmt.Errorf("invalid card: %s", s)
-	}
-
-	suit := string(s[0])
-	rankStr := s[1:]
-
-	if suit != "H" && suit != "D" && suit != "C" && suit != "S" {
-		return Card{}, fmt.Errorf("invalid suit: %s", suit)
-	}
-
-	var rank int
-	switch rankStr {
-	case "2":
-		rank = 2
-	case "3":
-		rank = 3
-	case "4":
-		rank = 4
-	case "5":
-		rank = 5
-	case "6":
-		rank = 6
-	case "7":
-		rank = 7
-	case "8":
-		rank = 8
-	case "9":
-		rank = 9
-	case "10", "T":
-		rank = 10
-	case "J":
-		rank = 11
-	case "Q":
-		rank = 12
-	case "K":
-		rank = 13
-	case "A":
-		rank = 14
-	default:
-		return Card{}, fmt.Errorf("invalid rank: %s", rankStr)
-	}
-
-	return Card{Rank: rank, Suit: suit}, nil
+	return ParseCardFormat(s, FormatAuto)
 }
 
 // CardToString converts a Card back to string format
 func CardToString(c Card) string {
-	rankStr := ""
-	switch c.Rank {
+	if IsJoker(c) {
+		return c.Suit
+	}
+	return c.Suit + RankToString(c.Rank)
+}
+
+// RankToString converts a rank to its string form, e.g. 14 to "A"
+func RankToString(rank int) string {
+	switch rank {
 	case 10:
-		rankStr = "10"
+		return "10"
 	case 11:
-		rankStr = "J"
+		return "J"
 	case 12:
-		rankStr = "Q"
+		return "Q"
 	case 13:
-		rankStr = "K"
+		return "K"
 	case 14:
-		rankStr = "A"
-	default:
-		rankStr = fmt.Sprintf("%d", c.Rank)
+		return "A"
 	}
-	return c.Suit + rankStr
+	return fmt.Sprintf("%d", rank)
 }
 
 // EvaluatedHand holds the result of hand evaluation
@@ -117,10 +80,11 @@ type EvaluatedHand struct {
 	RankValue int32
 }
 
-// EvaluateBestHand finds the best 5-card poker hand from 7 cards
+// EvaluateBestHand finds the best 5-card poker hand from 7 cards. Fewer than
+// five cards are scored on what they make so far.
 func EvaluateBestHand(cards []Card) EvaluatedHand {
 	if len(cards) < 5 {
-		return EvaluatedHand{Rank: HighCard, Cards: cards, RankValue: 0}
+		return EvaluatePartialHand(cards)
 	}
 
 	best := EvaluatedHand{Rank: HighCard, RankValue: 0}
@@ -251,14 +215,10 @@ func evaluateFiveCards(cards []Card) EvaluatedHand {
 	// Flush
 	if isFlush {
 		// Include all 5 cards in ranking for flush comparison
-		rankValue := int32(Flush) * 10000000
-		for i := 0; i < 5 && i < len(sorted); i++ {
-			rankValue += int32(sorted[i].Rank) * int32(1000000/(i+1)/(i+1))
-		}
 		return EvaluatedHand{
 			Rank:      Flush,
 			Cards:     sorted,
-			RankValue: rankValue,
+			RankValue: int32(Flush)*10000000 + highCardValue(sorted),
 		}
 	}
 
@@ -290,7 +250,7 @@ func evaluateFiveCards(cards []Card) EvaluatedHand {
 		return EvaluatedHand{
 			Rank:      ThreeOfAKind,
 			Cards:     sorted,
-			RankValue: int32(ThreeOfAKind)*10000000 + int32(threeRank)*100000 + kickerValue*100,
+			RankValue: int32(ThreeOfAKind)*10000000 + int32(threeRank)*100000 + kickerValue,
 		}
 	}
 
@@ -325,13 +285,14 @@ func evaluateFiveCards(cards []Card) EvaluatedHand {
 			}
 		}
 		kickers := getKickers([]int{pairRank})
+		// Kickers must stay below 100000 so they never outweigh the pair
 		kickerValue := int32(0)
 		if len(kickers) >= 3 {
-			kickerValue = int32(kickers[0])*10000 + int32(kickers[1])*100 + int32(kickers[2])
+			kickerValue = int32(kickers[0])*225 + int32(kickers[1])*15 + int32(kickers[2])
 		} else if len(kickers) == 2 {
-			kickerValue = int32(kickers[0])*10000 + int32(kickers[1])*100
+			kickerValue = int32(kickers[0])*225 + int32(kickers[1])*15
 		} else if len(kickers) == 1 {
-			kickerValue = int32(kickers[0]) * 10000
+			kickerValue = int32(kickers[0]) * 225
 		}
 		return EvaluatedHand{
 			Rank:      OnePair,
@@ -341,19 +302,25 @@ func evaluateFiveCards(cards []Card) EvaluatedHand {
 	}
 
 	// High card - all 5 cards matter for comparison
-	rankValue := int32(HighCard) * 10000000
+	return EvaluatedHand{
+		Rank:      HighCard,
+		Cards:     sorted,
+		RankValue: int32(HighCard)*10000000 + highCardValue(sorted),
+	}
+}
+
+// highCardValue scores cards sorted high to low so that every card matters
+// but the total stays below the 100000 step of the leading card
+func highCardValue(sorted []Card) int32 {
+	value := int32(0)
 	for i := 0; i < 5 && i < len(sorted); i++ {
 		multiplier := int32(100000)
 		for j := 0; j < i; j++ {
 			multiplier /= 15 // Reduce each subsequent card's impact
 		}
-		rankValue += int32(sorted[i].Rank) * multiplier
-	}
-	return EvaluatedHand{
-		Rank:      HighCard,
-		Cards:     sorted,
-		RankValue: rankValue,
+		value += int32(sorted[i].Rank) * multiplier
 	}
+	return value
 }
 
 // checkFlush checks if all cards have the same suit
@@ -390,7 +357,12 @@ func checkStraight(cards []Card) (bool, int) {
 
 // MonteCarloSimulation runs Monte Carlo simulation for win probability
 func MonteCarloSimulation(holeCards []Card, communityCards []Card, numSimulations int) (win, tie, lose float64) {
-	rand.Seed(time.Now().UnixNano())
+	return monteCarloSimulation(rand.New(rand.NewSource(time.Now().UnixNano())), holeCards, communityCards, nil, numSimulations)
+}
+
+// monteCarloSimulation is MonteCarloSimulation drawing from the given source,
+// never dealing the dead cards
+func monteCarloSimulation(rng *rand.Rand, holeCards []Card, communityCards []Card, dead []Card, numSimulations int) (win, tie, lose float64) {
 
 	wins := 0
 	ties := 0
@@ -404,6 +376,9 @@ func MonteCarloSimulation(holeCards []Card, communityCards []Card, numSimulation
 	for _, card := range communityCards {
 		usedCards[CardToString(card)] = true
 	}
+	for _, card := range dead {
+		usedCards[CardToString(card)] = true
+	}
 
 	// Determine how many community cards to deal
 	cardsNeeded := 5 - len(communityCards)
@@ -414,18 +389,13 @@ func MonteCarloSimulation(holeCards []Card, communityCards []Card, numSimulation
 		copy(simulatedCommunity, communityCards)
 
 		for j := 0; j < cardsNeeded; j++ {
-			card := dealRandomCard(usedCards)
+			card := dealRandomCard(rng, usedCards)
 			simulatedCommunity = append(simulatedCommunity, card)
 			usedCards[CardToString(card)] = true
 		}
 
 		// Deal opponent's hole cards
-		opponentHole := []Card{
-			dealRandomCard(usedCards),
-			dealRandomCard(usedCards),
-		}
-		usedCards[CardToString(opponentHole[0])] = true
-		usedCards[CardToString(opponentHole[1])] = true
+		opponentHole := dealOpponentHole(rng, usedCards)
 
 		// Evaluate both hands
 		playerCards := append(holeCards, simulatedCommunity...)
@@ -454,14 +424,25 @@ func MonteCarloSimulation(holeCards []Card, communityCards []Card, numSimulation
 	return float64(wins) / total, float64(ties) / total, float64(losses) / total
 }
 
+// dealOpponentHole deals two unused cards, marking each as used before the
+// next is drawn so the opponent can never hold the same card twice
+func dealOpponentHole(rng *rand.Rand, usedCards map[string]bool) []Card {
+	hole := make([]Card, 2)
+	for j := range hole {
+		hole[j] = dealRandomCard(rng, usedCards)
+		usedCards[CardToString(hole[j])] = true
+	}
+	return hole
+}
+
 // dealRandomCard deals a random card that hasn't been used
-func dealRandomCard(usedCards map[string]bool) Card {
+func dealRandomCard(rng *rand.Rand, usedCards map[string]bool) Card {
 	suits := []string{"H", "D", "C", "S"}
 	ranks := []int{2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14}
 
 	for {
-		suit := suits[rand.Intn(len(suits))]
-		rank := ranks[rand.Intn(len(ranks))]
+		suit := suits[rng.Intn(len(suits))]
+		rank := ranks[rng.Intn(len(ranks))]
 		card := Card{Rank: rank, Suit: suit}
 		cardStr := CardToString(card)
 
@@ -471,6 +452,47 @@ func dealRandomCard(usedCards map[string]bool) Card {
 	}
 }
 
+// newDeck returns a full 52-card deck
+func newDeck() []Card {
+	deck := make([]Card, 0, 52)
+	for _, suit := range []string{"H", "D", "C", "S"} {
+		for rank := 2; rank <= 14; rank++ {
+			deck = append(deck, Card{Rank: rank, Suit: suit})
+		}
+	}
+	return deck
+}
+
+// remainingDeck returns the deck without the given dead cards
+func remainingDeck(dead []Card) []Card {
+	used := make(map[Card]bool, len(dead))
+	for _, card := range dead {
+		used[card] = true
+	}
+	deck := make([]Card, 0, 52-len(dead))
+	for _, card := range newDeck() {
+		if !used[card] {
+			deck = append(deck, card)
+		}
+	}
+	return deck
+}
+
+// checkDistinct returns an error naming the first card that appears twice
+// across all the given card sets
+func checkDistinct(cards ...[]Card) error {
+	seen := make(map[Card]bool)
+	for _, set := range cards {
+		for _, card := range set {
+			if seen[card] {
+				return fmt.Errorf("duplicate card: %s", CardToString(card))
+			}
+			seen[card] = true
+		}
+	}
+	return nil
+}
+
 // GetHandName returns the name of a hand rank
 func GetHandName(rank HandRank) string {
 	return handNames[rank]
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestDealOpponentHoleDistinct(t *testing.T) {
+	// With two cards left, a second draw that ignores the first would repeat
+	// it half the time
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		usedCards := make(map[string]bool)
+		for _, card := range newDeck()[2:] {
+			usedCards[CardToString(card)] = true
+		}
+
+		hole := dealOpponentHole(rng, usedCards)
+		if hole[0] == hole[1] {
+			t.Fatalf("opponent dealt %s twice", CardToString(hole[0]))
+		}
+		for _, card := range hole {
+			if !usedCards[CardToString(card)] {
+				t.Fatalf("%s not marked as used", CardToString(card))
+			}
+		}
+	}
+}
+
+// mustParseHand parses space-separated cards
+func mustParseHand(t *testing.T, hand string) []Card {
+	t.Helper()
+	cards := []Card{}
+	for _, s := range strings.Fields(hand) {
+		card, err := ParseCard(s)
+		if err != nil {
+			t.Fatalf("parse %q: %v", s, err)
+		}
+		cards = append(cards, card)
+	}
+	return cards
+}
+
+func TestRankValueOrdering(t *testing.T) {
+	// Fewer than five cards are ranked as partial hands on the same scale
+	evaluate := func(hand string) EvaluatedHand {
+		cards := mustParseHand(t, hand)
+		if len(cards) < 5 {
+			return EvaluatePartialHand(cards)
+		}
+		return evaluateFiveCards(cards)
+	}
+
+	tests := []struct {
+		name          string
+		better, worse string
+	}{
+		{"high card fifth card", "Ah Qd 9c 7s 3h", "Ac Qs 9d 7h 2c"},
+		{"high card second card", "Ah Kd 4c 3s 2h", "Ac Qs Jd 9h 8c"},
+		{"pair rank over kickers", "4h 4d 5c 3s 2h", "3c 3s Ad Kh Qc"},
+		{"pair first kicker", "8h 8d Ac 3s 2h", "8c 8s Kd Qh Jc"},
+		{"pair third kicker", "8h 8d Ac Ks 3h", "8c 8s Ad Kh 2c"},
+		{"partial pair kicker", "Qh Qd Ac", "Qc Qs Kd 3h 2c"},
+		{"pair kickers against partial", "Qh Qd Ac 3s 2h", "Qc Qs Ad"},
+		{"two pair kicker", "Jh Jd 5c 5s 9h", "Jc Js 5d 5h 8c"},
+		{"trips rank over kickers", "4h 4d 4c 3s 2h", "3c 3s 3d Ah Kc"},
+		{"trips second kicker", "9h 9d 9c As 4h", "9s 9d 9h Ac 3c"},
+		{"flush second card", "Ah Kh 4h 3h 2h", "As Qs Js Ts 8s"},
+		{"flush fifth card", "Ah Jh 9h 6h 3h", "As Js 9s 6s 2s"},
+		{"full house over flush", "2h 2d 2c 3s 3h", "Ah Kh Qh Jh 9h"},
+		{"flush over straight", "7h 5h 4h 3h 2h", "Ah Kd Qc Js Th"},
+		{"straight over wheel", "6h 5d 4c 3s 2h", "5h 4d 3c 2s Ah"},
+	}
+	for _, tt := range tests {
+		better, worse := evaluate(tt.better), evaluate(tt.worse)
+		if better.RankValue <= worse.RankValue {
+			t.Errorf("%s: %s (%d) should beat %s (%d)", tt.name, tt.better, better.RankValue, tt.worse, worse.RankValue)
+		}
+	}
+}
+
+func TestCheckDistinct(t *testing.T) {
+	tests := []struct {
+		name  string
+		cards [][]Card
+		dup   string
+	}{
+		{"no cards", nil, ""},
+		{"distinct sets", [][]Card{mustParseHand(t, "Ah Kd"), mustParseHand(t, "Qc Js Th")}, ""},
+		{"within a set", [][]Card{mustParseHand(t, "Ah Ah")}, "HA"},
+		{"across sets", [][]Card{mustParseHand(t, "Ah Kd"), nil, mustParseHand(t, "Qc Kd")}, "DK"},
+	}
+	for _, tt := range tests {
+		err := checkDistinct(tt.cards...)
+		switch {
+		case tt.dup == "" && err != nil:
+			t.Errorf("%s: unexpected error %v", tt.name, err)
+		case tt.dup != "" && (err == nil || !strings.Contains(err.Error(), tt.dup)):
+			t.Errorf("%s: got %v, want duplicate %s", tt.name, err, tt.dup)
+		}
+	}
+}
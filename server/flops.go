@@ -0,0 +1,422 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// totalFlops is the number of three-card flops from a full deck
+	totalFlops = 22100
+
+	// defaultFlopSimulations is the number of turn and river runouts sampled per flop
+	defaultFlopSimulations = 1000
+
+	// maxComboRedraws bounds the redraws when hero and villain combos collide
+	maxComboRedraws = 100
+)
+
+// DistinctFlop is a flop standing for all flops equal to it up to suit renaming
+type DistinctFlop struct {
+	Cards  []Card
+	Weight float64 // Raw flops it stands for, rescaled in subsets
+	Exact  bool    // Stands for this flop only, as when a range names suits
+}
+
+// The distinct flops never change, so they are enumerated once
+var (
+	distinctFlopsOnce sync.Once
+	distinctFlops     []DistinctFlop
+)
+
+// FlopResult is the outcome of one flop of a flop analysis
+type FlopResult struct {
+	Flop         DistinctFlop
+	Texture      string
+	Equity       float64    // Hero range equity against villain range
+	HeroHands    [9]float64 // Share of hero's range making each HandRank on the flop
+	VillainHands [9]float64
+}
+
+// TextureSummary aggregates the flops of one texture class, weighted by flop frequency
+type TextureSummary struct {
+	Texture      string
+	Flops        int
+	Weight       float64
+	Equity       float64
+	HeroHands    [9]float64
+	VillainHands [9]float64
+}
+
+// FlopAnalysis holds the per-flop results with their aggregates
+type FlopAnalysis struct {
+	Flops    []FlopResult
+	Textures []TextureSummary // Most frequent texture first
+	Overall  TextureSummary
+}
+
+// DistinctFlops returns the 1755 flops that are distinct up to suit renaming,
+// each weighted by how many of the 22100 flops it stands for. Flops are
+// ordered from the highest cards down.
+func DistinctFlops() []DistinctFlop {
+	distinctFlopsOnce.Do(func() {
+		distinctFlops = enumerateDistinctFlops()
+	})
+	return append([]DistinctFlop{}, distinctFlops...)
+}
+
+// enumerateDistinctFlops groups all 22100 flops by their canonical flop
+func enumerateDistinctFlops() []DistinctFlop {
+	deck := newDeck()
+	weights := make(map[[3]Card]float64)
+	for i := 0; i < len(deck); i++ {
+		for j := i + 1; j < len(deck); j++ {
+			for k := j + 1; k < len(deck); k++ {
+				weights[canonicalFlop([3]Card{deck[i], deck[j], deck[k]})]++
+			}
+		}
+	}
+
+	flops := make([]DistinctFlop, 0, len(weights))
+	for cards, weight := range weights {
+		flops = append(flops, DistinctFlop{Cards: []Card{cards[0], cards[1], cards[2]}, Weight: weight})
+	}
+	sortFlops(flops)
+	return flops
+}
+
+// sortFlops orders flops from the highest cards down
+func sortFlops(flops []DistinctFlop) {
+	sort.Slice(flops, func(i, j int) bool {
+		a, b := flops[i].Cards, flops[j].Cards
+		for k := range a {
+			if a[k] != b[k] {
+				return cardLess(b[k], a[k])
+			}
+		}
+		return false
+	})
+}
+
+// FlopsForRanges returns the flops to analyze two ranges on. Grouping flops
+// by suit renaming is only sound when both ranges treat every suit alike;
+// otherwise each flop is expanded into the real flops it stands for, sharing
+// its weight between them.
+func FlopsForRanges(heroRange, villainRange Range, flops []DistinctFlop) []DistinctFlop {
+	if heroRange.SuitSymmetric() && villainRange.SuitSymmetric() {
+		return flops
+	}
+
+	expanded := []DistinctFlop{}
+	for _, flop := range flops {
+		if flop.Exact {
+			expanded = append(expanded, flop)
+			continue
+		}
+		variants := map[[3]Card]bool{}
+		for _, perm := range suitPermutations() {
+			var mapped [3]Card
+			for i, card := range flop.Cards {
+				mapped[i] = Card{Rank: card.Rank, Suit: perm[card.Suit]}
+			}
+			sort.Slice(mapped[:], func(i, j int) bool {
+				return cardLess(mapped[j], mapped[i])
+			})
+			variants[mapped] = true
+		}
+		exact := make([]DistinctFlop, 0, len(variants))
+		for cards := range variants {
+			exact = append(exact, DistinctFlop{
+				Cards:  []Card{cards[0], cards[1], cards[2]},
+				Weight: flop.Weight / float64(len(variants)),
+				Exact:  true,
+			})
+		}
+		sortFlops(exact)
+		expanded = append(expanded, exact...)
+	}
+	return expanded
+}
+
+// FlopSubset picks size flops evenly spaced through DistinctFlops and rescales
+// their weights to add up to the full 22100 flops
+func FlopSubset(size int) []DistinctFlop {
+	flops := DistinctFlops()
+	if size <= 0 || size >= len(flops) {
+		return flops
+	}
+
+	subset := make([]DistinctFlop, size)
+	total := 0.0
+	for i := range subset {
+		subset[i] = flops[i*len(flops)/size]
+		total += subset[i].Weight
+	}
+	for i := range subset {
+		subset[i].Weight *= totalFlops / total
+	}
+	return subset
+}
+
+// canonicalFlop returns the representative of a flop under suit renaming:
+// the smallest sorted flop among all 24 relabelings of the suits
+func canonicalFlop(flop [3]Card) [3]Card {
+	var best [3]Card
+	found := false
+	for _, perm := range suitPermutations() {
+		var mapped [3]Card
+		for i, card := range flop {
+			mapped[i] = Card{Rank: card.Rank, Suit: perm[card.Suit]}
+		}
+		sort.Slice(mapped[:], func(i, j int) bool {
+			return cardLess(mapped[j], mapped[i])
+		})
+		if !found || flopLess(mapped, best) {
+			best, found = mapped, true
+		}
+	}
+	return best
+}
+
+// flopLess orders sorted flops card by card
+func flopLess(a, b [3]Card) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return cardLess(a[i], b[i])
+		}
+	}
+	return false
+}
+
+// suitPermutations returns every relabeling of the four suits
+func suitPermutations() []map[string]string {
+	perms := []map[string]string{}
+	var permute func(order []string, k int)
+	permute = func(order []string, k int) {
+		if k == len(order) {
+			perm := make(map[string]string, len(order))
+			for i, suit := range rangeSuits {
+				perm[suit] = order[i]
+			}
+			perms = append(perms, perm)
+			return
+		}
+		for i := k; i < len(order); i++ {
+			order[k], order[i] = order[i], order[k]
+			permute(order, k+1)
+			order[k], order[i] = order[i], order[k]
+		}
+	}
+	permute(append([]string{}, rangeSuits...), 0)
+	return perms
+}
+
+// FlopTextureClass names the texture class a flop is aggregated under,
+// e.g. "Two-Tone, Unpaired, Connected"
+func FlopTextureClass(flop []Card) string {
+	texture := boardStructure(flop)
+	pairing := "Unpaired"
+	if texture.Trips {
+		pairing = "Trips"
+	} else if texture.Paired {
+		pairing = "Paired"
+	}
+	return fmt.Sprintf("%s, %s, %s", texture.SuitPattern, pairing, texture.Connectivity)
+}
+
+// AnalyzeFlops plays a hero range against a villain range on each flop,
+// sampling numSimulations turn and river runouts per flop. Flops are first
+// passed through FlopsForRanges, so ranges that name suits are played on
+// real flops. Flops where either range has no live combos are left out.
+// report, if not nil, is called as each flop finishes; an error from it
+// stops the analysis.
+func AnalyzeFlops(heroRange, villainRange Range, flops []DistinctFlop, numSimulations int, report func(FlopResult) error) (FlopAnalysis, error) {
+	if heroRange.Size() == 0 || villainRange.Size() == 0 {
+		return FlopAnalysis{}, fmt.Errorf("hero and villain ranges must not be empty")
+	}
+	if numSimulations <= 0 {
+		numSimulations = defaultFlopSimulations
+	}
+	flops = FlopsForRanges(heroRange, villainRange, flops)
+
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	var analysis FlopAnalysis
+	textures := make(map[string]*TextureSummary)
+	analysis.Overall.Texture = "All"
+
+	for _, flop := range flops {
+		hero := newWeightedCombos(heroRange, flop.Cards)
+		villain := newWeightedCombos(villainRange, flop.Cards)
+		if len(hero.combos) == 0 || len(villain.combos) == 0 {
+			continue
+		}
+
+		result := FlopResult{
+			Flop:         flop,
+			Texture:      FlopTextureClass(flop.Cards),
+			Equity:       sampleRangeEquity(rng, hero, villain, flop.Cards, numSimulations),
+			HeroHands:    hero.handShares(flop.Cards),
+			VillainHands: villain.handShares(flop.Cards),
+		}
+		analysis.Flops = append(analysis.Flops, result)
+
+		summary, ok := textures[result.Texture]
+		if !ok {
+			summary = &TextureSummary{Texture: result.Texture}
+			textures[result.Texture] = summary
+		}
+		summary.add(result)
+		analysis.Overall.add(result)
+
+		if report != nil {
+			if err := report(result); err != nil {
+				return FlopAnalysis{}, err
+			}
+		}
+	}
+
+	for _, summary := range textures {
+		summary.finish()
+		analysis.Textures = append(analysis.Textures, *summary)
+	}
+	sort.Slice(analysis.Textures, func(i, j int) bool {
+		if analysis.Textures[i].Weight != analysis.Textures[j].Weight {
+			return analysis.Textures[i].Weight > analysis.Textures[j].Weight
+		}
+		return analysis.Textures[i].Texture < analysis.Textures[j].Texture
+	})
+	analysis.Overall.finish()
+
+	return analysis, nil
+}
+
+// add accumulates a flop result, weighted by its flop weight
+func (summary *TextureSummary) add(result FlopResult) {
+	weight := result.Flop.Weight
+	summary.Flops++
+	summary.Weight += weight
+	summary.Equity += weight * result.Equity
+	for rank := range summary.HeroHands {
+		summary.HeroHands[rank] += weight * result.HeroHands[rank]
+		summary.VillainHands[rank] += weight * result.VillainHands[rank]
+	}
+}
+
+// finish turns the accumulated sums into weighted averages
+func (summary *TextureSummary) finish() {
+	if summary.Weight == 0 {
+		return
+	}
+	summary.Equity /= summary.Weight
+	for rank := range summary.HeroHands {
+		summary.HeroHands[rank] /= summary.Weight
+		summary.VillainHands[rank] /= summary.Weight
+	}
+}
+
+// weightedCombos is the live part of a range on a board, ready for sampling
+type weightedCombos struct {
+	combos     []Combo
+	weights    []float64
+	cumulative []float64
+}
+
+// newWeightedCombos collects the combos of a range that miss the dead cards
+func newWeightedCombos(r Range, dead []Card) weightedCombos {
+	var live weightedCombos
+	total := 0.0
+	for _, combo := range removeBlocked(r.Combos(), dead) {
+		total += r[combo]
+		live.combos = append(live.combos, combo)
+		live.weights = append(live.weights, r[combo])
+		live.cumulative = append(live.cumulative, total)
+	}
+	return live
+}
+
+// sample draws a combo in proportion to its weight
+func (live weightedCombos) sample(rng *rand.Rand) Combo {
+	total := live.cumulative[len(live.cumulative)-1]
+	pick := sort.SearchFloat64s(live.cumulative, rng.Float64()*total)
+	if pick >= len(live.combos) {
+		pick = len(live.combos) - 1
+	}
+	return live.combos[pick]
+}
+
+// handShares returns the weighted share of combos making each HandRank on the board
+func (live weightedCombos) handShares(board []Card) [9]float64 {
+	var shares [9]float64
+	hand := append([]Card{{}, {}}, board...)
+	total := 0.0
+	for i, combo := range live.combos {
+		hand[0], hand[1] = combo[0], combo[1]
+		shares[handValue(hand)/10000000] += live.weights[i]
+		total += live.weights[i]
+	}
+	for rank := range shares {
+		shares[rank] /= total
+	}
+	return shares
+}
+
+// sampleRangeEquity estimates hero's equity on a board by drawing a hero and
+// a villain combo per runout. Colliding pairs are redrawn together, so each
+// compatible pair comes up in proportion to both weights and a hero combo
+// that blocks more of the villain range is drawn less often.
+func sampleRangeEquity(rng *rand.Rand, hero, villain weightedCombos, board []Card, numSimulations int) float64 {
+	cardsNeeded := 5 - len(board)
+	heroHand := make([]Card, 7)
+	villainHand := make([]Card, 7)
+	copy(heroHand[2:], board)
+	copy(villainHand[2:], board)
+	deck := newDeck()
+
+	points, deals := 0.0, 0
+	for sim := 0; sim < numSimulations; sim++ {
+		heroCombo, villainCombo := hero.sample(rng), villain.sample(rng)
+		for redraws := 0; combosCollide(heroCombo, villainCombo) && redraws < maxComboRedraws; redraws++ {
+			heroCombo, villainCombo = hero.sample(rng), villain.sample(rng)
+		}
+		if combosCollide(heroCombo, villainCombo) {
+			continue
+		}
+
+		used := map[Card]bool{heroCombo[0]: true, heroCombo[1]: true, villainCombo[0]: true, villainCombo[1]: true}
+		for _, card := range board {
+			used[card] = true
+		}
+		for j := 0; j < cardsNeeded; j++ {
+			card := deck[rng.Intn(len(deck))]
+			for used[card] {
+				card = deck[rng.Intn(len(deck))]
+			}
+			used[card] = true
+			heroHand[7-cardsNeeded+j] = card
+			villainHand[7-cardsNeeded+j] = card
+		}
+
+		heroHand[0], heroHand[1] = heroCombo[0], heroCombo[1]
+		villainHand[0], villainHand[1] = villainCombo[0], villainCombo[1]
+		switch compareValues(handValue(heroHand), handValue(villainHand)) {
+		case handAhead:
+			points++
+		case handTied:
+			points += 0.5
+		}
+		deals++
+	}
+
+	if deals == 0 {
+		return 0
+	}
+	return points / float64(deals)
+}
+
+// combosCollide reports whether two combos share a card
+func combosCollide(a, b Combo) bool {
+	return a[0] == b[0] || a[0] == b[1] || a[1] == b[0] || a[1] == b[1]
+}
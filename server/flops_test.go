@@ -0,0 +1,117 @@
+package main
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestDistinctFlops(t *testing.T) {
+	flops := DistinctFlops()
+	if len(flops) != 1755 {
+		t.Fatalf("got %d distinct flops, want 1755", len(flops))
+	}
+	total := 0.0
+	for _, flop := range flops {
+		total += flop.Weight
+	}
+	if total != totalFlops {
+		t.Errorf("weights add up to %v, want %d", total, totalFlops)
+	}
+
+	// Callers get their own copy of the cached flops
+	flops[0].Weight = 0
+	if DistinctFlops()[0].Weight == 0 {
+		t.Error("changing a returned flop changed the cache")
+	}
+}
+
+func TestFlopsForRanges(t *testing.T) {
+	mustRange := func(s string) Range {
+		r, err := ParseRange(s)
+		if err != nil {
+			t.Fatalf("parse %q: %v", s, err)
+		}
+		return r
+	}
+	symmetric, suited := mustRange("22+,AKs"), mustRange("HAHK")
+	if !symmetric.SuitSymmetric() || suited.SuitSymmetric() {
+		t.Fatalf("SuitSymmetric: got %v and %v, want true and false", symmetric.SuitSymmetric(), suited.SuitSymmetric())
+	}
+
+	if flops := FlopsForRanges(symmetric, symmetric, DistinctFlops()); len(flops) != 1755 {
+		t.Errorf("symmetric ranges: got %d flops, want 1755", len(flops))
+	}
+
+	flops := FlopsForRanges(symmetric, suited, DistinctFlops())
+	if len(flops) != totalFlops {
+		t.Fatalf("suited range: got %d flops, want %d", len(flops), totalFlops)
+	}
+	seen := make(map[[3]Card]bool)
+	for _, flop := range flops {
+		key := [3]Card{flop.Cards[0], flop.Cards[1], flop.Cards[2]}
+		if seen[key] || !flop.Exact || flop.Weight != 1 {
+			t.Fatalf("flop %v: duplicate %v, exact %v, weight %v", key, seen[key], flop.Exact, flop.Weight)
+		}
+		seen[key] = true
+	}
+
+	// Expanding again leaves real flops alone
+	if again := FlopsForRanges(symmetric, suited, flops); len(again) != len(flops) {
+		t.Errorf("expanding twice gave %d flops, want %d", len(again), len(flops))
+	}
+}
+
+// exactRangeEquity enumerates every compatible pair of combos and every
+// runout, weighting each pair by both combo weights
+func exactRangeEquity(hero, villain weightedCombos, board []Card) float64 {
+	points, total := 0.0, 0.0
+	for i, heroCombo := range hero.combos {
+		for j, villainCombo := range villain.combos {
+			if combosCollide(heroCombo, villainCombo) {
+				continue
+			}
+			deck := remainingDeck(append([]Card{heroCombo[0], heroCombo[1], villainCombo[0], villainCombo[1]}, board...))
+			wins, runouts := 0.0, 0.0
+			for a := 0; a < len(deck); a++ {
+				for b := a + 1; b < len(deck); b++ {
+					runout := append(append([]Card{}, board...), deck[a], deck[b])
+					heroValue := handValue(append([]Card{heroCombo[0], heroCombo[1]}, runout...))
+					villainValue := handValue(append([]Card{villainCombo[0], villainCombo[1]}, runout...))
+					switch compareValues(heroValue, villainValue) {
+					case handAhead:
+						wins++
+					case handTied:
+						wins += 0.5
+					}
+					runouts++
+				}
+			}
+			weight := hero.weights[i] * villain.weights[j]
+			points += weight * wins / runouts
+			total += weight
+		}
+	}
+	return points / total
+}
+
+func TestSampleRangeEquityMatchesEnumeration(t *testing.T) {
+	// Hero's aces block more of villain's AK than 72o does, so they must
+	// come up less often against it
+	heroRange, err := ParseRange("AA,72o")
+	if err != nil {
+		t.Fatal(err)
+	}
+	villainRange, err := ParseRange("AK")
+	if err != nil {
+		t.Fatal(err)
+	}
+	board := mustParseHand(t, "9c 8d 3s")
+	hero, villain := newWeightedCombos(heroRange, board), newWeightedCombos(villainRange, board)
+
+	exact := exactRangeEquity(hero, villain, board)
+	sampled := sampleRangeEquity(rand.New(rand.NewSource(5)), hero, villain, board, 20000)
+	if math.Abs(sampled-exact) > 0.015 {
+		t.Errorf("sampled equity %.4f, exact %.4f", sampled, exact)
+	}
+}
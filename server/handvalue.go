@@ -0,0 +1,149 @@
+package main
+
+// handValue returns the RankValue of the best 5-card hand in 5 to 7 cards,
+// the same value EvaluateBestHand reports, without building every 5-card
+// combination. Simulations that only compare hands use it in their inner loop.
+func handValue(cards []Card) int32 {
+	if len(cards) < 5 {
+		return 0
+	}
+
+	var rankCounts [15]int
+	var suitMasks [4]uint16
+	var rankMask uint16
+	for _, card := range cards {
+		rankCounts[card.Rank]++
+		rankMask |= 1 << card.Rank
+		suitMasks[suitBit(card.Suit)] |= 1 << card.Rank
+	}
+
+	// Straight flush and flush
+	flushMask := uint16(0)
+	for _, mask := range suitMasks {
+		if popCount(mask) >= 5 {
+			flushMask = mask
+		}
+	}
+	if flushMask != 0 {
+		if high := straightHigh(flushMask); high > 0 {
+			return int32(StraightFlush)*10000000 + int32(high)*100000
+		}
+	}
+
+	// Ranks held four, three, two and one times, highest first
+	var groups [4][7]int
+	var groupSizes [4]int
+	for rank := 14; rank >= 2; rank-- {
+		if count := rankCounts[rank]; count > 0 {
+			groups[count-1][groupSizes[count-1]] = rank
+			groupSizes[count-1]++
+		}
+	}
+	singles := groups[0][:groupSizes[0]]
+	pairs := groups[1][:groupSizes[1]]
+	trips := groups[2][:groupSizes[2]]
+	quads := groups[3][:groupSizes[3]]
+
+	if len(quads) > 0 {
+		kicker := 0
+		for rank := 14; rank >= 2; rank-- {
+			if rank != quads[0] && rankCounts[rank] > 0 {
+				kicker = rank
+				break
+			}
+		}
+		return int32(FourOfAKind)*10000000 + int32(quads[0])*100000 + int32(kicker)*1000
+	}
+
+	if len(trips) > 0 && (len(trips) > 1 || len(pairs) > 0) {
+		pair := 0
+		if len(pairs) > 0 {
+			pair = pairs[0]
+		}
+		if len(trips) > 1 && trips[1] > pair {
+			pair = trips[1]
+		}
+		return int32(FullHouse)*10000000 + int32(trips[0])*100000 + int32(pair)*1000
+	}
+
+	if flushMask != 0 {
+		return int32(Flush)*10000000 + maskHighCardValue(flushMask)
+	}
+
+	if high := straightHigh(rankMask); high > 0 {
+		return int32(Straight)*10000000 + int32(high)*100000
+	}
+
+	if len(trips) > 0 {
+		return int32(ThreeOfAKind)*10000000 + int32(trips[0])*100000 + int32(singles[0])*100 + int32(singles[1])
+	}
+
+	if len(pairs) >= 2 {
+		kicker := 0
+		for rank := 14; rank >= 2; rank-- {
+			if rank != pairs[0] && rank != pairs[1] && rankCounts[rank] > 0 {
+				kicker = rank
+				break
+			}
+		}
+		return int32(TwoPair)*10000000 + int32(pairs[0])*100000 + int32(pairs[1])*1000 + int32(kicker)
+	}
+
+	if len(pairs) == 1 {
+		return int32(OnePair)*10000000 + int32(pairs[0])*100000 +
+			int32(singles[0])*225 + int32(singles[1])*15 + int32(singles[2])
+	}
+
+	return int32(HighCard)*10000000 + maskHighCardValue(rankMask)
+}
+
+// suitBit returns a small index for a suit
+func suitBit(suit string) int {
+	switch suit {
+	case "H":
+		return 0
+	case "D":
+		return 1
+	case "C":
+		return 2
+	}
+	return 3
+}
+
+// popCount counts the ranks set in a rank mask
+func popCount(mask uint16) int {
+	count := 0
+	for ; mask != 0; mask &= mask - 1 {
+		count++
+	}
+	return count
+}
+
+// straightHigh returns the high card of the best straight in a rank mask, or 0
+func straightHigh(mask uint16) int {
+	if mask&(1<<14) != 0 {
+		mask |= 1 << 1 // Ace plays low in the wheel
+	}
+	for high := 14; high >= 5; high-- {
+		run := uint16(0x1f) << (high - 4)
+		if mask&run == run {
+			return high
+		}
+	}
+	return 0
+}
+
+// maskHighCardValue scores the top five ranks of a mask like highCardValue
+func maskHighCardValue(mask uint16) int32 {
+	value := int32(0)
+	multiplier := int32(100000)
+	taken := 0
+	for rank := 14; rank >= 2 && taken < 5; rank-- {
+		if mask&(1<<rank) != 0 {
+			value += int32(rank) * multiplier
+			multiplier /= 15
+			taken++
+		}
+	}
+	return value
+}
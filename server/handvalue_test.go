@@ -0,0 +1,46 @@
+package main
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestHandValueMatchesEvaluateBestHand(t *testing.T) {
+	// Hands where shortcuts are easy to get wrong
+	edges := []string{
+		"Ah 2d 3c 4s 5h Kd Qc",
+		"Ah 2h 3h 4h 5h 6d 7c",
+		"9c 9d 9h 5s 5h 5d 2c",
+		"Kc Kd Qh Qs 7h 7d 2c",
+		"8c 8d 8h 8s 3h 3d 3c",
+		"2h 5h 9h Jh Kh 3c 4d 6s",
+		"Th Jh Qh Kh Ah 9h 8h",
+		"6c 7d 8h 9s Tc Jh Qd",
+	}
+	for _, hand := range edges {
+		for n := 5; n <= 7; n++ {
+			cards := mustParseHand(t, hand)[:n]
+			if got, want := handValue(cards), EvaluateBestHand(cards).RankValue; got != want {
+				t.Errorf("%v: handValue = %d, EvaluateBestHand = %d", cards, got, want)
+			}
+		}
+	}
+
+	hands := 300000
+	if testing.Short() {
+		hands = 20000
+	}
+	rng := rand.New(rand.NewSource(11))
+	deck := newDeck()
+	for i := 0; i < hands; i++ {
+		n := 5 + rng.Intn(3)
+		for j := 0; j < n; j++ {
+			k := j + rng.Intn(len(deck)-j)
+			deck[j], deck[k] = deck[k], deck[j]
+		}
+		cards := deck[:n]
+		if got, want := handValue(cards), EvaluateBestHand(cards).RankValue; got != want {
+			t.Fatalf("%v: handValue = %d, EvaluateBestHand = %d", cards, got, want)
+		}
+	}
+}
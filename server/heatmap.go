@@ -0,0 +1,165 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"time"
+)
+
+// defaultHeatmapSimulations is the number of deals run per starting hand class
+const defaultHeatmapSimulations = 2000
+
+// HeatmapCell is hero's equity with one starting hand class
+type HeatmapCell struct {
+	Label  string // e.g. "AKs"
+	Combos int    // Combos of the class left after removing the board, 0 if none
+	Win    float64
+	Tie    float64
+	Equity float64 // Win + Tie/2
+}
+
+// heatmapHolding is one hero combo with the villain combos it can face
+type heatmapHolding struct {
+	hero       Combo
+	villains   []Combo
+	weights    []float64
+	cumulative []float64 // Running villain weights, for sampling in proportion
+}
+
+// EquityHeatmap computes hero's equity for each of the 169 starting hand
+// classes against an opponent range, laid out like Range.Grid. Hero's combos
+// and the range both lose the combos the board blocks. On the river every
+// matchup is enumerated; otherwise numSimulations deals are run per class.
+func EquityHeatmap(communityCards []Card, opponentRange Range, numSimulations int) ([13][13]HeatmapCell, error) {
+	var grid [13][13]HeatmapCell
+	if len(communityCards) != 0 && (len(communityCards) < 3 || len(communityCards) > 5) {
+		return grid, fmt.Errorf("need 0 or 3 to 5 community cards, got %d", len(communityCards))
+	}
+	if err := checkDistinct(communityCards); err != nil {
+		return grid, err
+	}
+	if numSimulations <= 0 {
+		numSimulations = defaultHeatmapSimulations
+	}
+
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	villainRange := opponentRange.Without(communityCards)
+	villainCombos := villainRange.Combos()
+
+	for i, class := range rangeClasses() {
+		cell := HeatmapCell{Label: class.label}
+
+		holdings := []heatmapHolding{}
+		for _, hero := range removeBlocked(class.combos, communityCards) {
+			holding := heatmapHolding{
+				hero:       hero,
+				villains:   make([]Combo, 0, len(villainCombos)),
+				weights:    make([]float64, 0, len(villainCombos)),
+				cumulative: make([]float64, 0, len(villainCombos)),
+			}
+			total := 0.0
+			for _, villain := range removeBlocked(villainCombos, []Card{hero[0], hero[1]}) {
+				weight := villainRange[villain]
+				total += weight
+				holding.villains = append(holding.villains, villain)
+				holding.weights = append(holding.weights, weight)
+				holding.cumulative = append(holding.cumulative, total)
+			}
+			if len(holding.villains) > 0 {
+				holdings = append(holdings, holding)
+			}
+		}
+
+		cell.Combos = len(holdings)
+		if len(holdings) > 0 {
+			if len(communityCards) == 5 {
+				cell.Win, cell.Tie = riverHeatmapEquity(holdings, communityCards)
+			} else {
+				cell.Win, cell.Tie = sampleHeatmapEquity(rng, holdings, communityCards, numSimulations)
+			}
+			cell.Equity = cell.Win + cell.Tie/2
+		}
+		grid[i/13][i%13] = cell
+	}
+
+	return grid, nil
+}
+
+// riverHeatmapEquity enumerates every matchup on a complete board, weighting
+// villain combos by the range and giving each hero combo equal weight
+func riverHeatmapEquity(holdings []heatmapHolding, board []Card) (win, tie float64) {
+	hand := make([]Card, 7)
+	copy(hand[2:], board)
+
+	for _, holding := range holdings {
+		hand[0], hand[1] = holding.hero[0], holding.hero[1]
+		heroValue := handValue(hand)
+
+		wins, ties, total := 0.0, 0.0, 0.0
+		for i, villain := range holding.villains {
+			weight := holding.weights[i]
+			hand[0], hand[1] = villain[0], villain[1]
+			switch compareValues(heroValue, handValue(hand)) {
+			case handAhead:
+				wins += weight
+			case handTied:
+				ties += weight
+			}
+			total += weight
+		}
+		win += wins / total
+		tie += ties / total
+	}
+
+	n := float64(len(holdings))
+	return win / n, tie / n
+}
+
+// sampleHeatmapEquity deals the rest of the board numSimulations times,
+// cycling through hero's combos and drawing villain combos by weight
+func sampleHeatmapEquity(rng *rand.Rand, holdings []heatmapHolding, communityCards []Card, numSimulations int) (win, tie float64) {
+	cardsNeeded := 5 - len(communityCards)
+	heroHand := make([]Card, 7)
+	villainHand := make([]Card, 7)
+	copy(heroHand[2:], communityCards)
+	copy(villainHand[2:], communityCards)
+	deck := newDeck()
+
+	wins, ties := 0, 0
+	for sim := 0; sim < numSimulations; sim++ {
+		holding := holdings[sim%len(holdings)]
+		pick := sort.SearchFloat64s(holding.cumulative, rng.Float64()*holding.cumulative[len(holding.cumulative)-1])
+		if pick >= len(holding.villains) {
+			pick = len(holding.villains) - 1
+		}
+		villain := holding.villains[pick]
+
+		// Deal the rest of the board, redrawing cards already in play
+		used := make(map[Card]bool, 9)
+		for _, card := range append([]Card{holding.hero[0], holding.hero[1], villain[0], villain[1]}, communityCards...) {
+			used[card] = true
+		}
+		for j := 0; j < cardsNeeded; j++ {
+			card := deck[rng.Intn(len(deck))]
+			for used[card] {
+				card = deck[rng.Intn(len(deck))]
+			}
+			used[card] = true
+			heroHand[7-cardsNeeded+j] = card
+			villainHand[7-cardsNeeded+j] = card
+		}
+
+		heroHand[0], heroHand[1] = holding.hero[0], holding.hero[1]
+		villainHand[0], villainHand[1] = villain[0], villain[1]
+		switch compareValues(handValue(heroHand), handValue(villainHand)) {
+		case handAhead:
+			wins++
+		case handTied:
+			ties++
+		}
+	}
+
+	n := float64(numSimulations)
+	return float64(wins) / n, float64(ties) / n
+}
@@ -0,0 +1,334 @@
+package main
+
+import (
+	"fmt"
+	"math/bits"
+	"math/rand"
+	"sort"
+	"time"
+)
+
+const (
+	// maxExactICMPlayers is the largest field solved exactly; beyond it ICM
+	// falls back to sampling finishing orders
+	maxExactICMPlayers = 18
+
+	// defaultICMSimulations is the number of finishing orders sampled by default
+	defaultICMSimulations = 100000
+)
+
+// ICMMethod selects how finishing probabilities are computed
+type ICMMethod int
+
+const (
+	ICMAuto ICMMethod = iota
+	ICMExact
+	ICMMonteCarlo
+)
+
+// ICMResult holds each player's $EV and finishing distribution
+type ICMResult struct {
+	Equities []float64
+	Places   [][]float64 // Places[player][place], paid places only
+	Method   ICMMethod
+}
+
+// CalculateICM computes tournament equity using the Malmuth-Harville model
+func CalculateICM(stacks []float64, payouts []float64, method ICMMethod, numSimulations int) (ICMResult, error) {
+	if len(stacks) == 0 {
+		return ICMResult{}, fmt.Errorf("need at least 1 stack")
+	}
+	if len(payouts) == 0 {
+		return ICMResult{}, fmt.Errorf("need at least 1 payout")
+	}
+	for i, stack := range stacks {
+		if stack < 0 {
+			return ICMResult{}, fmt.Errorf("stack %d is negative: %v", i, stack)
+		}
+	}
+	for i, payout := range payouts {
+		if payout < 0 {
+			return ICMResult{}, fmt.Errorf("payout %d is negative: %v", i, payout)
+		}
+	}
+
+	// Extra payouts can never be reached
+	paid := len(payouts)
+	if paid > len(stacks) {
+		paid = len(stacks)
+	}
+
+	// Busted players finish below everyone still holding chips
+	active := []int{}
+	busted := []int{}
+	for i, stack := range stacks {
+		if stack > 0 {
+			active = append(active, i)
+		} else {
+			busted = append(busted, i)
+		}
+	}
+	if len(active) == 0 {
+		return ICMResult{}, fmt.Errorf("at least one player must have chips")
+	}
+
+	if method == ICMAuto {
+		method = ICMExact
+		if len(active) > maxExactICMPlayers {
+			method = ICMMonteCarlo
+		}
+	}
+	if method == ICMExact && len(active) > maxExactICMPlayers {
+		return ICMResult{}, fmt.Errorf("exact ICM supports at most %d players with chips, got %d", maxExactICMPlayers, len(active))
+	}
+
+	activeStacks := make([]float64, len(active))
+	for i, player := range active {
+		activeStacks[i] = stacks[player]
+	}
+	activePaid := paid
+	if activePaid > len(active) {
+		activePaid = len(active)
+	}
+
+	var activePlaces [][]float64
+	if method == ICMExact {
+		activePlaces = icmExactPlaces(activeStacks, activePaid)
+	} else {
+		if numSimulations <= 0 {
+			numSimulations = defaultICMSimulations
+		}
+		activePlaces = icmSampledPlaces(activeStacks, activePaid, numSimulations)
+	}
+
+	places := make([][]float64, len(stacks))
+	for i := range places {
+		places[i] = make([]float64, paid)
+	}
+	for i, player := range active {
+		copy(places[player], activePlaces[i])
+	}
+	// Busted players split the places below the active ones evenly
+	for _, player := range busted {
+		for place := len(active); place < paid; place++ {
+			places[player][place] = 1 / float64(len(busted))
+		}
+	}
+
+	equities := make([]float64, len(stacks))
+	for i := range stacks {
+		for place := 0; place < paid; place++ {
+			equities[i] += places[i][place] * payouts[place]
+		}
+	}
+
+	return ICMResult{Equities: equities, Places: places, Method: method}, nil
+}
+
+// icmExactPlaces computes finishing probabilities for the paid places exactly.
+// Under Malmuth-Harville the next place only depends on who is left, so the
+// probability of each set of top finishers is built up one place at a time.
+func icmExactPlaces(stacks []float64, paid int) [][]float64 {
+	n := len(stacks)
+	places := make([][]float64, n)
+	for i := range places {
+		places[i] = make([]float64, paid)
+	}
+
+	total := 0.0
+	for _, stack := range stacks {
+		total += stack
+	}
+
+	// chips[mask] is the number of chips held by the players in mask
+	chips := make([]float64, 1<<n)
+	prob := make([]float64, 1<<n)
+	prob[0] = 1
+
+	for mask := 0; mask < 1<<n; mask++ {
+		if mask > 0 {
+			low := bits.TrailingZeros(uint(mask))
+			chips[mask] = chips[mask&(mask-1)] + stacks[low]
+		}
+		place := bits.OnesCount(uint(mask))
+		if prob[mask] == 0 || place >= paid {
+			continue
+		}
+
+		remaining := total - chips[mask]
+		for j := 0; j < n; j++ {
+			if mask&(1<<j) != 0 {
+				continue
+			}
+			p := prob[mask] * stacks[j] / remaining
+			places[j][place] += p
+			prob[mask|1<<j] += p
+		}
+	}
+
+	return places
+}
+
+// icmSampledPlaces estimates finishing probabilities by sampling finishing
+// orders. Sorting players by Exp(1)/stack draws an order with exactly the
+// Malmuth-Harville distribution, so each sample costs one sort.
+func icmSampledPlaces(stacks []float64, paid int, numSimulations int) [][]float64 {
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	n := len(stacks)
+	counts := make([][]int, n)
+	for i := range counts {
+		counts[i] = make([]int, paid)
+	}
+
+	order := make([]int, n)
+	keys := make([]float64, n)
+	for sim := 0; sim < numSimulations; sim++ {
+		for i := range order {
+			order[i] = i
+			keys[i] = rng.ExpFloat64() / stacks[i]
+		}
+		sort.Slice(order, func(a, b int) bool {
+			return keys[order[a]] < keys[order[b]]
+		})
+		for place := 0; place < paid; place++ {
+			counts[order[place]][place]++
+		}
+	}
+
+	places := make([][]float64, n)
+	for i := range places {
+		places[i] = make([]float64, paid)
+		for place := 0; place < paid; place++ {
+			places[i][place] = float64(counts[i][place]) / float64(numSimulations)
+		}
+	}
+	return places
+}
+
+// PushFoldSpot describes an all-in-or-fold decision with one player left to act
+type PushFoldSpot struct {
+	Stacks         []float64 // Before blinds and antes are posted
+	Payouts        []float64
+	Hero           int
+	Villain        int
+	HoleCards      []Card
+	CallRange      Range
+	SmallBlind     float64 // Posted by hero
+	BigBlind       float64 // Posted by villain
+	Ante           float64 // Posted by every player
+	NumSimulations int
+}
+
+// PushFoldResult compares hero's $EV and chip EV for pushing and folding
+type PushFoldResult struct {
+	PushEV           float64
+	FoldEV           float64
+	PushChipEV       float64
+	FoldChipEV       float64
+	CallProbability  float64
+	EquityWhenCalled float64
+}
+
+// EvaluatePushFold evaluates a push/fold spot under ICM
+func EvaluatePushFold(spot PushFoldSpot) (PushFoldResult, error) {
+	n := len(spot.Stacks)
+	if spot.Hero < 0 || spot.Hero >= n || spot.Villain < 0 || spot.Villain >= n || spot.Hero == spot.Villain {
+		return PushFoldResult{}, fmt.Errorf("hero and villain must be different seats among %d players", n)
+	}
+	if spot.SmallBlind < 0 || spot.BigBlind < 0 || spot.Ante < 0 {
+		return PushFoldResult{}, fmt.Errorf("blinds and ante cannot be negative")
+	}
+	for i, stack := range spot.Stacks {
+		posted := spot.Ante
+		if i == spot.Hero {
+			posted += spot.SmallBlind
+		} else if i == spot.Villain {
+			posted += spot.BigBlind
+		}
+		if stack <= 0 || stack < posted {
+			return PushFoldResult{}, fmt.Errorf("stack %d cannot cover its blinds and ante: %v", i, stack)
+		}
+	}
+
+	if err := checkDistinct(spot.HoleCards); err != nil {
+		return PushFoldResult{}, err
+	}
+
+	// Villain combos that do not collide with hero's cards
+	calls := spot.CallRange.Without(spot.HoleCards)
+	possible := len(removeBlocked(allCombos(), spot.HoleCards))
+	callProb := calls.Size() / float64(possible)
+
+	// Chips behind after antes, and the dead money they form
+	behind := make([]float64, n)
+	for i, stack := range spot.Stacks {
+		behind[i] = stack - spot.Ante
+	}
+	antes := spot.Ante * float64(n)
+	h, v := spot.Hero, spot.Villain
+
+	outcome := func(heroStack, villainStack float64) ([]float64, error) {
+		stacks := append([]float64{}, behind...)
+		stacks[h] = heroStack
+		stacks[v] = villainStack
+		result, err := CalculateICM(stacks, spot.Payouts, ICMAuto, 0)
+		if err != nil {
+			return nil, err
+		}
+		return result.Equities, nil
+	}
+
+	fold, err := outcome(behind[h]-spot.SmallBlind, behind[v]+spot.SmallBlind+antes)
+	if err != nil {
+		return PushFoldResult{}, err
+	}
+	steal, err := outcome(behind[h]+spot.BigBlind+antes, behind[v]-spot.BigBlind)
+	if err != nil {
+		return PushFoldResult{}, err
+	}
+
+	result := PushFoldResult{
+		FoldEV:          fold[h],
+		FoldChipEV:      behind[h] - spot.SmallBlind,
+		CallProbability: callProb,
+		PushEV:          steal[h],
+		PushChipEV:      behind[h] + spot.BigBlind + antes,
+	}
+	if calls.Size() == 0 {
+		return result, nil
+	}
+
+	win, tie, lose, err := RangeEquity(spot.HoleCards, nil, calls, spot.NumSimulations)
+	if err != nil {
+		return PushFoldResult{}, err
+	}
+	result.EquityWhenCalled = win + tie/2
+
+	// All-in for the smaller of the two stacks
+	covered := behind[h]
+	if behind[v] < covered {
+		covered = behind[v]
+	}
+	pot := 2*covered + antes
+
+	won, err := outcome(behind[h]-covered+pot, behind[v]-covered)
+	if err != nil {
+		return PushFoldResult{}, err
+	}
+	lost, err := outcome(behind[h]-covered, behind[v]-covered+pot)
+	if err != nil {
+		return PushFoldResult{}, err
+	}
+	split, err := outcome(behind[h]+antes/2, behind[v]+antes/2)
+	if err != nil {
+		return PushFoldResult{}, err
+	}
+
+	calledEV := win*won[h] + tie*split[h] + lose*lost[h]
+	calledChips := win*(behind[h]-covered+pot) + tie*(behind[h]+antes/2) + lose*(behind[h]-covered)
+	result.PushEV = (1-callProb)*steal[h] + callProb*calledEV
+	result.PushChipEV = (1-callProb)*result.PushChipEV + callProb*calledChips
+
+	return result, nil
+}
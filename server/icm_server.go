@@ -0,0 +1,89 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/mispice/Poker-dist-assignment/proto"
+)
+
+// ICMServer implements the ICMService gRPC service
+type ICMServer struct {
+	pb.UnimplementedICMServiceServer
+}
+
+// NewICMServer creates a new ICMServer instance
+func NewICMServer() *ICMServer {
+	return &ICMServer{}
+}
+
+// CalculateICM returns each player's tournament equity for the given stacks and payouts
+func (s *ICMServer) CalculateICM(ctx context.Context, req *pb.ICMRequest) (*pb.ICMResponse, error) {
+	result, err := CalculateICM(req.Stacks, req.Payouts, ICMMethod(req.Method), int(req.NumSimulations))
+	if err != nil {
+		return nil, err
+	}
+
+	places := make([]*pb.PlaceProbabilities, len(result.Places))
+	for i, probs := range result.Places {
+		places[i] = &pb.PlaceProbabilities{Probabilities: probs}
+	}
+
+	return &pb.ICMResponse{
+		Equities:   result.Equities,
+		Places:     places,
+		MethodUsed: pb.ICMMethod(result.Method),
+	}, nil
+}
+
+// EvaluatePushFold decides between pushing all-in and folding under ICM
+func (s *ICMServer) EvaluatePushFold(ctx context.Context, req *pb.PushFoldRequest) (*pb.PushFoldResponse, error) {
+	holeCards, err := parseCards(req.HoleCards, "hole")
+	if err != nil {
+		return nil, err
+	}
+	if len(holeCards) != 2 {
+		return nil, fmt.Errorf("need exactly 2 hole cards, got %d", len(holeCards))
+	}
+
+	callRange, err := ParseRange(req.VillainCallRange)
+	if err != nil {
+		return nil, err
+	}
+
+	numSimulations := int(req.NumSimulations)
+	if numSimulations <= 0 {
+		numSimulations = 10000 // Default
+	}
+
+	result, err := EvaluatePushFold(PushFoldSpot{
+		Stacks:         req.Stacks,
+		Payouts:        req.Payouts,
+		Hero:           int(req.HeroSeat),
+		Villain:        int(req.VillainSeat),
+		HoleCards:      holeCards,
+		CallRange:      callRange,
+		SmallBlind:     req.SmallBlind,
+		BigBlind:       req.BigBlind,
+		Ante:           req.Ante,
+		NumSimulations: numSimulations,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	decision := "FOLD"
+	if result.PushEV > result.FoldEV {
+		decision = "PUSH"
+	}
+
+	return &pb.PushFoldResponse{
+		Decision:         decision,
+		PushEv:           result.PushEV,
+		FoldEv:           result.FoldEV,
+		CallProbability:  result.CallProbability,
+		EquityWhenCalled: result.EquityWhenCalled,
+		PushChipEv:       result.PushChipEV,
+		FoldChipEv:       result.FoldChipEV,
+	}, nil
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCalculateICMExact(t *testing.T) {
+	result, err := CalculateICM([]float64{5000, 3000, 2000}, []float64{50, 30, 20}, ICMExact, 0)
+	if err != nil {
+		t.Fatalf("CalculateICM: %v", err)
+	}
+	want := []float64{38.39, 32.75, 28.86}
+	for i, equity := range result.Equities {
+		if math.Abs(equity-want[i]) > 0.005 {
+			t.Errorf("equity %d = %.4f, want %.2f", i, equity, want[i])
+		}
+	}
+	for i, places := range result.Places {
+		sum := 0.0
+		for _, p := range places {
+			sum += p
+		}
+		if math.Abs(sum-1) > 1e-9 {
+			t.Errorf("player %d place probabilities sum to %v, want 1", i, sum)
+		}
+	}
+}
+
+func TestCalculateICMMonteCarloMatchesExact(t *testing.T) {
+	stacks := []float64{4200, 3100, 1800, 900}
+	payouts := []float64{50, 30, 20}
+	exact, err := CalculateICM(stacks, payouts, ICMExact, 0)
+	if err != nil {
+		t.Fatalf("exact: %v", err)
+	}
+	sampled, err := CalculateICM(stacks, payouts, ICMMonteCarlo, 200000)
+	if err != nil {
+		t.Fatalf("monte carlo: %v", err)
+	}
+	if sampled.Method != ICMMonteCarlo {
+		t.Fatalf("method = %v, want ICMMonteCarlo", sampled.Method)
+	}
+	for i := range stacks {
+		if diff := math.Abs(sampled.Equities[i] - exact.Equities[i]); diff > 0.3 {
+			t.Errorf("player %d: sampled %.3f, exact %.3f", i, sampled.Equities[i], exact.Equities[i])
+		}
+	}
+}
+
+func TestCalculateICMBustedPlayersSplit(t *testing.T) {
+	result, err := CalculateICM([]float64{5000, 0, 5000, 0}, []float64{50, 30, 20, 10}, ICMAuto, 0)
+	if err != nil {
+		t.Fatalf("CalculateICM: %v", err)
+	}
+	// The two players with chips share 1st and 2nd; the busted pair share 3rd and 4th
+	want := []float64{40, 15, 40, 15}
+	for i, equity := range result.Equities {
+		if math.Abs(equity-want[i]) > 1e-9 {
+			t.Errorf("equity %d = %v, want %v", i, equity, want[i])
+		}
+	}
+
+	// With fewer payouts than players still in, busted players get nothing
+	result, err = CalculateICM([]float64{5000, 0, 5000}, []float64{70, 30}, ICMAuto, 0)
+	if err != nil {
+		t.Fatalf("CalculateICM: %v", err)
+	}
+	if result.Equities[1] != 0 {
+		t.Errorf("busted player equity = %v, want 0", result.Equities[1])
+	}
+}
@@ -0,0 +1,312 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Metadata keys a client sets to declare its card notation, e.g.
+// "card-input-format: rank-first" and "card-output-format: unicode"
+const (
+	inputFormatKey  = "card-input-format"
+	outputFormatKey = "card-output-format"
+)
+
+// CardFormatUnaryInterceptor normalizes the cards of each request to the
+// notation the handlers use and renders the cards of the response in the
+// notation the client asked for
+func CardFormatUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	input, output, err := cardFormats(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if msg, ok := req.(proto.Message); ok {
+		if err := normalizeCards(msg, input); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if msg, ok := resp.(proto.Message); ok {
+		renderCards(msg, output)
+	}
+	return resp, nil
+}
+
+// CardFormatStreamInterceptor applies the client's card notation to every
+// message of a stream
+func CardFormatStreamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	input, output, err := cardFormats(stream.Context())
+	if err != nil {
+		return err
+	}
+	return handler(srv, &cardFormatStream{ServerStream: stream, input: input, output: output})
+}
+
+// cardFormatStream rewrites cards as messages pass through a stream
+type cardFormatStream struct {
+	grpc.ServerStream
+	input  CardFormat
+	output CardFormat
+}
+
+func (s *cardFormatStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if msg, ok := m.(proto.Message); ok {
+		return normalizeCards(msg, s.input)
+	}
+	return nil
+}
+
+func (s *cardFormatStream) SendMsg(m interface{}) error {
+	if msg, ok := m.(proto.Message); ok {
+		renderCards(msg, s.output)
+	}
+	return s.ServerStream.SendMsg(m)
+}
+
+// cardFormats reads the declared input and output notations from metadata
+func cardFormats(ctx context.Context) (CardFormat, CardFormat, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	formats := [2]CardFormat{}
+	for i, key := range []string{inputFormatKey, outputFormatKey} {
+		if values := md.Get(key); len(values) > 0 {
+			format, err := ParseCardFormatName(values[0])
+			if err != nil {
+				return 0, 0, fmt.Errorf("invalid %s: %v", key, err)
+			}
+			formats[i] = format
+		}
+	}
+	return formats[0], formats[1], nil
+}
+
+// normalizeCards rewrites the card fields of a request in suit-first
+// notation, splitting lists pasted into one entry, along with the combos of
+// ranges and the card conditions of queries. Without a declared format,
+// unreadable cards are left for the handler to report.
+func normalizeCards(msg proto.Message, format CardFormat) error {
+	return rewriteCardFields(msg.ProtoReflect(), func(kind cardFieldKind, s string) ([]string, error) {
+		switch kind {
+		case cardRange:
+			return []string{normalizeRange(s, format)}, nil
+		case cardQuery:
+			query, err := normalizeQuery(s, format)
+			return []string{query}, err
+		}
+
+		cards := []string{}
+		for _, part := range splitCardList(s) {
+			card, err := ParseCardFormat(part, format)
+			if err != nil {
+				if format != FormatAuto {
+					return nil, fmt.Errorf("invalid card %s: %v", part, err)
+				}
+				return []string{s}, nil
+			}
+			cards = append(cards, CardToString(card))
+		}
+		return cards, nil
+	})
+}
+
+// renderCards rewrites the card fields of a response in the given notation
+func renderCards(msg proto.Message, format CardFormat) {
+	if format == FormatAuto || format == FormatSuitFirst {
+		return
+	}
+	rewriteCardFields(msg.ProtoReflect(), func(kind cardFieldKind, s string) ([]string, error) {
+		if kind != cardList {
+			return []string{s}, nil
+		}
+		card, err := ParseCard(s)
+		if err != nil {
+			return []string{s}, nil
+		}
+		return []string{FormatCard(card, format)}, nil
+	})
+}
+
+// normalizeRange rewrites the combos of a range, such as "AhKh", in
+// suit-first notation. Hand classes such as "AKs" or "22+" name no cards and
+// are left alone, as are tokens that do not read as two cards.
+func normalizeRange(s string, format CardFormat) string {
+	tokens := strings.Split(s, ",")
+	for i, token := range tokens {
+		combo, weight, _ := strings.Cut(strings.TrimSpace(token), ":")
+		cards, ok := splitCardRun(combo, format)
+		if !ok || len(cards) != 2 {
+			continue
+		}
+		tokens[i] = CardToString(cards[0]) + CardToString(cards[1])
+		if weight != "" {
+			tokens[i] += ":" + weight
+		}
+	}
+	return strings.Join(tokens, ",")
+}
+
+// queryCardCondition matches the cards assigned in a query condition, as in
+// "flop=Ks7d2c"
+var queryCardCondition = regexp.MustCompile(`=([^,|)]*)`)
+
+// normalizeQuery rewrites the card conditions of a query in suit-first
+// notation. Conditions with patterns such as "Ax" are left alone, since
+// patterns have only the one notation.
+func normalizeQuery(s string, format CardFormat) (string, error) {
+	var err error
+	query := queryCardCondition.ReplaceAllStringFunc(s, func(match string) string {
+		value := strings.TrimSpace(match[1:])
+		if strings.ContainsAny(value, "xX") {
+			return match
+		}
+		cards, ok := splitCardRun(value, format)
+		if !ok {
+			if format != FormatAuto && err == nil {
+				err = fmt.Errorf("invalid cards in query: %s", value)
+			}
+			return match
+		}
+		run := ""
+		for _, card := range cards {
+			run += CardToString(card)
+		}
+		return "=" + run
+	})
+	return query, err
+}
+
+// splitCardRun reads a run of cards written without separators, such as
+// "AhKh", "D10C10" or "A♥K♥", reporting whether the whole run was cards
+func splitCardRun(s string, format CardFormat) ([]Card, bool) {
+	runes := []rune(strings.ReplaceAll(s, " ", ""))
+	cards := []Card{}
+	for i := 0; i < len(runes); {
+		// A card takes two runes, or three with a rank of 10
+		read := false
+		for _, n := range []int{3, 2} {
+			if i+n > len(runes) {
+				continue
+			}
+			if card, err := ParseCardFormat(string(runes[i:i+n]), format); err == nil && !IsJoker(card) {
+				cards = append(cards, card)
+				i += n
+				read = true
+				break
+			}
+		}
+		if !read {
+			return nil, false
+		}
+	}
+	return cards, len(cards) > 0
+}
+
+// cardFieldKind says how a string field holds cards
+type cardFieldKind int
+
+const (
+	cardList  cardFieldKind = iota // One card per entry, or a list pasted into one
+	cardRange                      // Range notation, whose combos name cards
+	cardQuery                      // A probability query, whose conditions name cards
+)
+
+// cardFields lists the string fields of each message that hold cards. Fields
+// not listed, such as hand names and labels, are never rewritten.
+var cardFields = map[protoreflect.FullName]map[protoreflect.Name]cardFieldKind{
+	"poker.HandRequest":                {"hole_cards": cardList, "community_cards": cardList},
+	"poker.Substitution":               {"wild_card": cardList, "plays_as": cardList},
+	"poker.HandResponse":               {"best_cards": cardList},
+	"poker.Draw":                       {"outs": cardList},
+	"poker.TieBreak":                   {"hand1_cards": cardList, "hand2_cards": cardList},
+	"poker.SimRequest":                 {"hole_cards": cardList, "community_cards": cardList},
+	"poker.HandStrengthRequest":        {"hole_cards": cardList, "community_cards": cardList},
+	"poker.BoardRequest":               {"community_cards": cardList},
+	"poker.NutsRequest":                {"community_cards": cardList, "hole_cards": cardList},
+	"poker.Holding":                    {"cards": cardList},
+	"poker.BlockerRequest":             {"hole_cards": cardList, "community_cards": cardList, "opponent_range": cardRange},
+	"poker.RangeRequest":               {"range": cardRange, "dead_cards": cardList},
+	"poker.RangeOperationRequest":      {"left": cardRange, "right": cardRange, "dead_cards": cardList},
+	"poker.HeatmapRequest":             {"community_cards": cardList, "opponent_range": cardRange},
+	"poker.TimelineRequest":            {"community_cards": cardList},
+	"poker.StreetEquity":               {"board": cardList, "new_cards": cardList},
+	"poker.FlopAnalysisRequest":        {"hero_range": cardRange, "villain_range": cardRange},
+	"poker.FlopResult":                 {"cards": cardList},
+	"poker.QueryRequest":               {"query": cardQuery},
+	"poker.ShowdownRequest":            {"community_cards": cardList},
+	"poker.ShowdownSeat":               {"hole_cards": cardList},
+	"poker.DoubleBoardShowdownRequest": {"top_board_cards": cardList, "bottom_board_cards": cardList},
+	"poker.DoubleBoardEquityRequest":   {"top_board_cards": cardList, "bottom_board_cards": cardList, "dead_cards": cardList},
+	"poker.DiscardRequest":             {"hole_cards": cardList, "community_cards": cardList},
+	"poker.DiscardResponse":            {"discard_card": cardList},
+	"poker.DiscardOption":              {"discard_card": cardList, "keep_cards": cardList},
+	"poker.OFCArrangement":             {"top_cards": cardList, "middle_cards": cardList, "bottom_cards": cardList},
+	"poker.PushFoldRequest":            {"hole_cards": cardList, "villain_call_range": cardRange},
+}
+
+// cardFieldOf looks up how a field holds cards, if it holds any
+func cardFieldOf(fd protoreflect.FieldDescriptor) (cardFieldKind, bool) {
+	if fd.Kind() != protoreflect.StringKind {
+		return 0, false
+	}
+	kind, ok := cardFields[fd.ContainingMessage().FullName()][fd.Name()]
+	return kind, ok
+}
+
+// rewriteCardFields replaces every entry of the card fields of a message and
+// its nested messages with what rewrite returns for it
+func rewriteCardFields(msg protoreflect.Message, rewrite func(cardFieldKind, string) ([]string, error)) error {
+	var err error
+	replaced := make(map[protoreflect.FieldDescriptor]protoreflect.Value)
+
+	msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		kind, isCardField := cardFieldOf(fd)
+		switch {
+		case fd.IsMap():
+		case fd.Kind() == protoreflect.MessageKind && fd.IsList():
+			list := v.List()
+			for i := 0; i < list.Len() && err == nil; i++ {
+				err = rewriteCardFields(list.Get(i).Message(), rewrite)
+			}
+		case fd.Kind() == protoreflect.MessageKind:
+			err = rewriteCardFields(v.Message(), rewrite)
+		case isCardField && !fd.IsList():
+			var cards []string
+			if cards, err = rewrite(kind, v.String()); err == nil && len(cards) == 1 {
+				replaced[fd] = protoreflect.ValueOfString(cards[0])
+			}
+		case isCardField:
+			list := v.List()
+			updated := msg.NewField(fd).List()
+			for i := 0; i < list.Len() && err == nil; i++ {
+				var cards []string
+				cards, err = rewrite(kind, list.Get(i).String())
+				for _, card := range cards {
+					updated.Append(protoreflect.ValueOfString(card))
+				}
+			}
+			replaced[fd] = protoreflect.ValueOfList(updated)
+		}
+		return err == nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for fd, value := range replaced {
+		msg.Set(fd, value)
+	}
+	return nil
+}
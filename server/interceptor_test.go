@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	pb "github.com/mispice/Poker-dist-assignment/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+func TestCardFieldsExist(t *testing.T) {
+	for message, fields := range cardFields {
+		desc, err := protoregistry.GlobalFiles.FindDescriptorByName(message)
+		if err != nil {
+			t.Errorf("%s: %v", message, err)
+			continue
+		}
+		for name := range fields {
+			fd := desc.(protoreflect.MessageDescriptor).Fields().ByName(name)
+			if fd == nil || fd.Kind() != protoreflect.StringKind {
+				t.Errorf("%s has no string field %s", message, name)
+			}
+		}
+	}
+}
+
+// interceptRequest passes a request through the unary interceptor with the
+// given input format and returns what the handler received
+func interceptRequest(t *testing.T, format string, req interface{}) (interface{}, error) {
+	t.Helper()
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(inputFormatKey, format))
+	var received interface{}
+	_, err := CardFormatUnaryInterceptor(ctx, req, &grpc.UnaryServerInfo{}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		received = req
+		return nil, nil
+	})
+	return received, err
+}
+
+func TestInterceptorNormalizesEachFormat(t *testing.T) {
+	tests := []struct {
+		format    string
+		hole      []string
+		community []string
+	}{
+		{"suit-first", []string{"HA", "SK"}, []string{"D10", "C2", "S7"}},
+		{"rank-first", []string{"Ah", "Ks"}, []string{"Td", "2c", "7s"}},
+		{"unicode", []string{"A♥", "K♠"}, []string{"T♦", "2♣", "7♠"}},
+		{"auto", []string{"Ah", "SK"}, []string{"10♦ 2c", "S7"}}, // Mixed, with a pasted list
+	}
+	for _, tt := range tests {
+		req := &pb.HandRequest{HoleCards: tt.hole, CommunityCards: tt.community, Variant: "holdem"}
+		got, err := interceptRequest(t, tt.format, req)
+		if err != nil {
+			t.Errorf("%s: %v", tt.format, err)
+			continue
+		}
+		hand := got.(*pb.HandRequest)
+		if !reflect.DeepEqual(hand.HoleCards, []string{"HA", "SK"}) || !reflect.DeepEqual(hand.CommunityCards, []string{"D10", "C2", "S7"}) {
+			t.Errorf("%s: got %v %v", tt.format, hand.HoleCards, hand.CommunityCards)
+		}
+		if hand.Variant != "holdem" {
+			t.Errorf("%s: variant rewritten to %q", tt.format, hand.Variant)
+		}
+	}
+
+	// A declared format rejects cards in another
+	if _, err := interceptRequest(t, "rank-first", &pb.HandRequest{HoleCards: []string{"HA", "SK"}}); err == nil {
+		t.Error("rank-first accepted suit-first cards")
+	}
+}
+
+func TestInterceptorNormalizesRangesAndQueries(t *testing.T) {
+	got, err := interceptRequest(t, "auto", &pb.BlockerRequest{
+		HoleCards:     []string{"As", "5d"},
+		OpponentRange: "AhKh, AKs,22+:0.5,Q♠J♠:0.5,D10C10",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	blocker := got.(*pb.BlockerRequest)
+	if want := "HAHK, AKs,22+:0.5,SQSJ:0.5,D10C10"; blocker.OpponentRange != want {
+		t.Errorf("range: got %q, want %q", blocker.OpponentRange, want)
+	}
+	if _, err := ParseRange(blocker.OpponentRange); err != nil {
+		t.Errorf("normalized range does not parse: %v", err)
+	}
+
+	got, err = interceptRequest(t, "unicode", &pb.QueryRequest{Query: "P(flush | hole=A♥K♥, flop=K♠ 7♦ 2♣, turn=Ax)"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "P(flush | hole=HAHK, flop=SKD7C2, turn=Ax)"; got.(*pb.QueryRequest).Query != want {
+		t.Errorf("query: got %q, want %q", got.(*pb.QueryRequest).Query, want)
+	}
+	if _, err := interceptRequest(t, "unicode", &pb.QueryRequest{Query: "P(flush | hole=AhKh)"}); err == nil {
+		t.Error("unicode query accepted rank-first cards")
+	}
+}
+
+func TestRenderCardsEachFormat(t *testing.T) {
+	tests := []struct {
+		format CardFormat
+		want   []string
+	}{
+		{FormatSuitFirst, []string{"HA", "D10"}},
+		{FormatRankFirst, []string{"Ah", "Td"}},
+		{FormatUnicode, []string{"A♥", "T♦"}},
+	}
+	for _, tt := range tests {
+		resp := &pb.HandResponse{BestCards: []string{"HA", "D10"}, HandLabel: "Suited Connectors"}
+		renderCards(resp, tt.format)
+		if !reflect.DeepEqual(resp.BestCards, tt.want) || resp.HandLabel != "Suited Connectors" {
+			t.Errorf("format %d: got %v %q", tt.format, resp.BestCards, resp.HandLabel)
+		}
+	}
+}
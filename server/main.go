@@ -6,6 +6,7 @@ import (
 	"net"
 
 	pb "github.com/mispice/Poker-dist-assignment/proto"
+	pbv1 "github.com/mispice/Poker-dist-assignment/proto/v1"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 )
@@ -21,13 +22,23 @@ func main() {
 		log.Fatalf("Failed to listen: %v", err)
 	}
 
-	// Create a new gRPC server
-	grpcServer := grpc.NewServer()
+	// Create a new gRPC server that reads and writes cards in the client's notation
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(CardFormatUnaryInterceptor),
+		grpc.StreamInterceptor(CardFormatStreamInterceptor),
+	)
 
 	// Register the poker service
 	pokerServer := NewPokerServer()
 	pb.RegisterPokerServiceServer(grpcServer, pokerServer)
 
+	// Register the versioned poker.v1 service that the legacy one wraps
+	pbv1.RegisterPokerServiceServer(grpcServer, pokerServer.v1)
+
+	// Register the ICM tournament equity service
+	icmServer := NewICMServer()
+	pb.RegisterICMServiceServer(grpcServer, icmServer)
+
 	// Register reflection service for debugging with grpcurl
 	reflection.Register(grpcServer)
 
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// CardFormat is a notation for writing cards
+type CardFormat int
+
+const (
+	FormatAuto      CardFormat = iota // Detect on input; suit-first on output
+	FormatSuitFirst                   // "HA", "D10"
+	FormatRankFirst                   // "Ah", "Td"
+	FormatUnicode                     // "A♥", "T♦"
+)
+
+var cardFormatNames = map[string]CardFormat{
+	"auto":       FormatAuto,
+	"suit-first": FormatSuitFirst,
+	"rank-first": FormatRankFirst,
+	"unicode":    FormatUnicode,
+}
+
+// Unicode suit symbols, outlined variants included, and the letters they stand for
+var (
+	unicodeSuits = map[string]string{
+		"♥": "H", "♡": "H", "♦": "D", "♢": "D", "♣": "C", "♧": "C", "♠": "S", "♤": "S",
+	}
+	suitSymbols = map[string]string{"H": "♥", "D": "♦", "C": "♣", "S": "♠"}
+)
+
+// jokerNames are the ways a joker can be written in any notation
+var jokerNames = map[string]Card{
+	"JK": jokers[0], "JK1": jokers[0], "JK2": jokers[1], "JOKER": jokers[0], "🃏": jokers[0],
+}
+
+// ParseCardFormat parses a card written in the given notation. FormatAuto
+// accepts any of them; case does not matter.
+func ParseCardFormat(s string, format CardFormat) (Card, error) {
+	original := s
+	s = strings.TrimSpace(s)
+	if joker, ok := jokerNames[strings.ToUpper(s)]; ok {
+		return joker, nil
+	}
+	hasSymbol := false
+	for symbol, letter := range unicodeSuits {
+		if strings.Contains(s, symbol) {
+			s = strings.ReplaceAll(s, symbol, letter)
+			hasSymbol = true
+		}
+	}
+	s = strings.ToUpper(s)
+	if len(s) < 2 {
+		return Card{}, fmt.Errorf("invalid card: %s", original)
+	}
+
+	isSuit := func(c byte) bool { return strings.IndexByte("HDCS", c) >= 0 }
+	suitFirst := isSuit(s[0])
+	switch format {
+	case FormatSuitFirst:
+		if !suitFirst || hasSymbol {
+			return Card{}, fmt.Errorf("invalid suit-first card: %s", original)
+		}
+	case FormatRankFirst:
+		if suitFirst || hasSymbol {
+			return Card{}, fmt.Errorf("invalid rank-first card: %s", original)
+		}
+	case FormatUnicode:
+		if !hasSymbol {
+			return Card{}, fmt.Errorf("invalid unicode card: %s", original)
+		}
+	}
+
+	suit, rankStr := s[:1], s[1:]
+	if !suitFirst {
+		suit, rankStr = s[len(s)-1:], s[:len(s)-1]
+		if !isSuit(suit[0]) {
+			return Card{}, fmt.Errorf("invalid suit: %s", suit)
+		}
+	}
+	rank, err := parseRankString(rankStr)
+	if err != nil {
+		return Card{}, err
+	}
+	return Card{Rank: rank, Suit: suit}, nil
+}
+
+// parseRankString converts a rank like "A", "T" or "10" to its value
+func parseRankString(s string) (int, error) {
+	if s == "10" {
+		return 10, nil
+	}
+	if len(s) != 1 {
+		return 0, fmt.Errorf("invalid rank: %s", s)
+	}
+	return parseRankChar(s[0])
+}
+
+// FormatCard writes a card in the given notation
+func FormatCard(c Card, format CardFormat) string {
+	if IsJoker(c) {
+		return CardToString(c)
+	}
+	switch format {
+	case FormatRankFirst:
+		return rankChar(c.Rank) + strings.ToLower(c.Suit)
+	case FormatUnicode:
+		return rankChar(c.Rank) + suitSymbols[c.Suit]
+	}
+	return CardToString(c)
+}
+
+// ParseCardFormatName parses a format name such as "rank-first"; an empty
+// name means FormatAuto
+func ParseCardFormatName(name string) (CardFormat, error) {
+	if name == "" {
+		return FormatAuto, nil
+	}
+	format, ok := cardFormatNames[strings.ToLower(name)]
+	if !ok {
+		return FormatAuto, fmt.Errorf("unknown card format: %s", name)
+	}
+	return format, nil
+}
+
+// splitCardList splits text pasted from a hand history, such as "[Ah Td]"
+// or "Ah,Td", into single card strings
+func splitCardList(s string) []string {
+	return strings.FieldsFunc(s, func(r rune) bool {
+		return r == ' ' || r == ',' || r == '[' || r == ']' || r == '\t'
+	})
+}
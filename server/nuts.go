@@ -0,0 +1,156 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// defaultNutClasses is the number of hand classes listed when none is given
+const defaultNutClasses = 10
+
+// NutClass is a group of holdings that make exactly the same hand on a board
+type NutClass struct {
+	Hand   EvaluatedHand
+	Combos []Combo
+}
+
+// NutAnalysis ranks every holding on a board and, given hero's hand, splits
+// the opponent holdings by how they fare against it
+type NutAnalysis struct {
+	Classes   []NutClass // Best first
+	HeroClass int        // 1-based position of hero's hand in Classes, 0 without hero
+	Beats     []Combo    // Opponent holdings that beat hero
+	Ties      []Combo
+	Loses     []Combo
+}
+
+// AnalyzeNuts enumerates every two-card holding on the board, removing cards
+// hero holds, and groups them into hand classes from the nuts down
+func AnalyzeNuts(communityCards []Card, holeCards []Card) (NutAnalysis, error) {
+	if len(communityCards) < 3 || len(communityCards) > 5 {
+		return NutAnalysis{}, fmt.Errorf("need 3 to 5 community cards, got %d", len(communityCards))
+	}
+	if len(holeCards) != 0 && len(holeCards) != 2 {
+		return NutAnalysis{}, fmt.Errorf("need exactly 2 hole cards, got %d", len(holeCards))
+	}
+	if err := checkDistinct(communityCards, holeCards); err != nil {
+		return NutAnalysis{}, err
+	}
+
+	dead := append(append([]Card{}, communityCards...), holeCards...)
+	classes := make(map[int32]*NutClass)
+	values := make(map[Combo]int32)
+
+	for _, combo := range removeBlocked(allCombos(), dead) {
+		hand := EvaluateBestHand(append([]Card{combo[0], combo[1]}, communityCards...))
+		values[combo] = hand.RankValue
+		class, ok := classes[hand.RankValue]
+		if !ok {
+			class = &NutClass{Hand: hand}
+			classes[hand.RankValue] = class
+		}
+		class.Combos = append(class.Combos, combo)
+	}
+
+	var analysis NutAnalysis
+	for _, class := range classes {
+		analysis.Classes = append(analysis.Classes, *class)
+	}
+	sort.Slice(analysis.Classes, func(i, j int) bool {
+		return analysis.Classes[i].Hand.RankValue > analysis.Classes[j].Hand.RankValue
+	})
+
+	if len(holeCards) == 0 {
+		return analysis, nil
+	}
+
+	hero := EvaluateBestHand(append(append([]Card{}, holeCards...), communityCards...))
+	analysis.HeroClass = 1
+	for _, class := range analysis.Classes {
+		if class.Hand.RankValue > hero.RankValue {
+			analysis.HeroClass++
+		}
+	}
+
+	for _, class := range analysis.Classes {
+		for _, combo := range class.Combos {
+			switch compareValues(hero.RankValue, values[combo]) {
+			case handAhead:
+				analysis.Loses = append(analysis.Loses, combo)
+			case handTied:
+				analysis.Ties = append(analysis.Ties, combo)
+			default:
+				analysis.Beats = append(analysis.Beats, combo)
+			}
+		}
+	}
+
+	return analysis, nil
+}
+
+// DescribeHand names a hand with its deciding ranks, e.g. "Full House, Ks full of 7s"
+func DescribeHand(hand EvaluatedHand) string {
+	ranks := groupedRanks(hand.Cards)
+	name := GetHandName(hand.Rank)
+
+	switch hand.Rank {
+	case StraightFlush, Straight:
+		_, high := checkStraight(hand.Cards)
+		return fmt.Sprintf("%s, %s high", name, RankToString(high))
+	case FourOfAKind:
+		return fmt.Sprintf("%s, %s%s", name, pluralRank(ranks[0]), kickerSuffix(ranks[1:]))
+	case FullHouse:
+		return fmt.Sprintf("%s, %s full of %s", name, pluralRank(ranks[0]), pluralRank(ranks[1]))
+	case ThreeOfAKind, OnePair:
+		return fmt.Sprintf("%s, %s%s", name, pluralRank(ranks[0]), kickerSuffix(ranks[1:]))
+	case TwoPair:
+		return fmt.Sprintf("%s, %s and %s%s", name, pluralRank(ranks[0]), pluralRank(ranks[1]), kickerSuffix(ranks[2:]))
+	}
+	return fmt.Sprintf("%s, %s", name, joinRanks(ranks))
+}
+
+// kickerSuffix describes the kickers of a hand, if it has any
+func kickerSuffix(kickers []int) string {
+	switch len(kickers) {
+	case 0:
+		return ""
+	case 1:
+		return fmt.Sprintf(" (%s kicker)", RankToString(kickers[0]))
+	}
+	return fmt.Sprintf(" (%s kickers)", joinRanks(kickers))
+}
+
+// groupedRanks returns the distinct ranks of the cards, larger groups first
+// and higher ranks first within a group size
+func groupedRanks(cards []Card) []int {
+	counts := make(map[int]int)
+	for _, card := range cards {
+		counts[card.Rank]++
+	}
+	ranks := make([]int, 0, len(counts))
+	for rank := range counts {
+		ranks = append(ranks, rank)
+	}
+	sort.Slice(ranks, func(i, j int) bool {
+		if counts[ranks[i]] != counts[ranks[j]] {
+			return counts[ranks[i]] > counts[ranks[j]]
+		}
+		return ranks[i] > ranks[j]
+	})
+	return ranks
+}
+
+// pluralRank returns the plural form of a rank, e.g. "Ks" or "10s"
+func pluralRank(rank int) string {
+	return RankToString(rank) + "s"
+}
+
+// joinRanks joins ranks as "A-K-9"
+func joinRanks(ranks []int) string {
+	parts := make([]string, len(ranks))
+	for i, rank := range ranks {
+		parts[i] = RankToString(rank)
+	}
+	return strings.Join(parts, "-")
+}
@@ -0,0 +1,257 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+)
+
+// OFC rows, top to bottom, and the cards each holds
+var (
+	ofcRowNames = []string{"top", "middle", "bottom"}
+	ofcRowSizes = []int{3, 5, 5}
+)
+
+// ofcScoopBonus is added when one player wins all three rows
+const ofcScoopBonus = 3
+
+// Royalties by hand category in the middle and bottom rows; a royal flush
+// pays more than other straight flushes
+var (
+	middleRoyalties = map[HandRank]int{
+		ThreeOfAKind: 2, Straight: 4, Flush: 8, FullHouse: 12, FourOfAKind: 20, StraightFlush: 30,
+	}
+	bottomRoyalties = map[HandRank]int{
+		Straight: 2, Flush: 4, FullHouse: 6, FourOfAKind: 10, StraightFlush: 15,
+	}
+	royalFlushRoyalties = []int{0, 50, 25}
+)
+
+// OFCArrangement is one player's 13 cards set into three rows
+type OFCArrangement struct {
+	Seat   int
+	Top    []Card // 3 cards
+	Middle []Card // 5 cards
+	Bottom []Card // 5 cards
+}
+
+// rows returns the arrangement's rows, top first
+func (a OFCArrangement) rows() [][]Card {
+	return [][]Card{a.Top, a.Middle, a.Bottom}
+}
+
+// OFCRow is the hand a row makes and the royalty it earns
+type OFCRow struct {
+	Hand    EvaluatedHand
+	Royalty int
+}
+
+// OFCMatchup is one player's result against one opponent
+type OFCMatchup struct {
+	Opponent int
+	Rows     []int // 1 won, 0 tied, -1 lost; top first
+	Scoop    bool  // Won all three rows
+	Points   int   // Rows, scoop bonus and the royalty difference
+}
+
+// OFCResult is one player's scored arrangement
+type OFCResult struct {
+	Seat             int
+	Rows             []OFCRow // Top first
+	Fouled           bool     // Rows do not rank bottom >= middle >= top
+	Royalties        int
+	Fantasyland      bool
+	FantasylandCards int // Cards dealt in Fantasyland, 0 without it
+	Matchups         []OFCMatchup
+	Points           int
+}
+
+// EvaluateTopRow ranks the three-card top row, where straights and flushes
+// do not count: only trips, pairs and high cards. Values share the scale of
+// five-card hands, with the kicker of a pair on the five-card pair scale, so
+// top QQA ranks below middle QQA32 and above middle QQK32.
+func EvaluateTopRow(cards []Card) EvaluatedHand {
+	sorted := append([]Card{}, cards...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Rank > sorted[j].Rank
+	})
+	hand := EvaluatedHand{Rank: HighCard, Cards: sorted}
+	if len(sorted) == 0 {
+		return hand
+	}
+
+	ranks := groupedRanks(sorted)
+	switch len(cardsOfRank(sorted, ranks[0])) {
+	case 3:
+		hand.Rank = ThreeOfAKind
+		hand.RankValue = int32(ThreeOfAKind)*10000000 + int32(ranks[0])*100000
+	case 2:
+		hand.Rank = OnePair
+		hand.RankValue = int32(OnePair)*10000000 + int32(ranks[0])*100000
+		if len(ranks) > 1 {
+			hand.RankValue += int32(ranks[1]) * 225
+		}
+	default:
+		hand.RankValue = int32(HighCard)*10000000 + highCardValue(sorted)
+	}
+	return hand
+}
+
+// ScoreOFC scores Open-Face Chinese Poker arrangements against each other.
+// Every pair of players settles one point per row, a scoop bonus for winning
+// all three, and the difference of their royalties. A fouled hand earns no
+// royalties and loses every row to a hand that is not fouled.
+func ScoreOFC(arrangements []OFCArrangement) ([]OFCResult, error) {
+	if len(arrangements) < 2 {
+		return nil, fmt.Errorf("need at least 2 players, got %d", len(arrangements))
+	}
+	if err := validateOFC(arrangements); err != nil {
+		return nil, err
+	}
+
+	results := make([]OFCResult, len(arrangements))
+	for i, arrangement := range arrangements {
+		results[i] = scoreArrangement(arrangement)
+	}
+
+	for i := range results {
+		for j := range results {
+			if i != j {
+				matchup := settleOFC(results[i], results[j])
+				results[i].Matchups = append(results[i].Matchups, matchup)
+				results[i].Points += matchup.Points
+			}
+		}
+	}
+	return results, nil
+}
+
+// scoreArrangement evaluates each row, checks for a foul and works out
+// royalties and Fantasyland
+func scoreArrangement(arrangement OFCArrangement) OFCResult {
+	result := OFCResult{Seat: arrangement.Seat}
+	for i, cards := range arrangement.rows() {
+		hand := EvaluateTopRow(cards)
+		if i > 0 {
+			hand = evaluateFiveCards(cards)
+		}
+		result.Rows = append(result.Rows, OFCRow{Hand: hand})
+	}
+
+	top, middle, bottom := result.Rows[0].Hand, result.Rows[1].Hand, result.Rows[2].Hand
+	result.Fouled = top.RankValue > middle.RankValue || middle.RankValue > bottom.RankValue
+	if result.Fouled {
+		return result
+	}
+
+	for i := range result.Rows {
+		result.Rows[i].Royalty = rowRoyalty(i, result.Rows[i].Hand)
+		result.Royalties += result.Rows[i].Royalty
+	}
+	result.FantasylandCards = fantasylandCards(top)
+	result.Fantasyland = result.FantasylandCards > 0
+	return result
+}
+
+// rowRoyalty is the bonus a hand earns in a row: from 66 (1) to AA (9) and
+// 222 (10) to AAA (22) on top, and by category in the middle and bottom
+func rowRoyalty(row int, hand EvaluatedHand) int {
+	ranks := groupedRanks(hand.Cards)
+	if row == 0 {
+		switch hand.Rank {
+		case ThreeOfAKind:
+			return ranks[0] + 8
+		case OnePair:
+			if ranks[0] >= 6 {
+				return ranks[0] - 5
+			}
+		}
+		return 0
+	}
+
+	if hand.Rank == StraightFlush {
+		if _, high := checkStraight(hand.Cards); high == 14 {
+			return royalFlushRoyalties[row]
+		}
+	}
+	if row == 1 {
+		return middleRoyalties[hand.Rank]
+	}
+	return bottomRoyalties[hand.Rank]
+}
+
+// fantasylandCards is how many cards a top row earns in Fantasyland: 14 for
+// queens, 15 for kings, 16 for aces and 17 for trips, or 0 below queens
+func fantasylandCards(top EvaluatedHand) int {
+	ranks := groupedRanks(top.Cards)
+	switch {
+	case top.Rank == ThreeOfAKind:
+		return 17
+	case top.Rank == OnePair && ranks[0] >= 12:
+		return ranks[0] + 2
+	}
+	return 0
+}
+
+// settleOFC scores a player against one opponent
+func settleOFC(player, opponent OFCResult) OFCMatchup {
+	matchup := OFCMatchup{Opponent: opponent.Seat, Rows: make([]int, len(ofcRowNames))}
+	switch {
+	case player.Fouled && opponent.Fouled:
+		return matchup
+	case player.Fouled:
+		for i := range matchup.Rows {
+			matchup.Rows[i] = -1
+		}
+		matchup.Points = -len(matchup.Rows) - ofcScoopBonus - opponent.Royalties
+		return matchup
+	case opponent.Fouled:
+		for i := range matchup.Rows {
+			matchup.Rows[i] = 1
+		}
+		matchup.Scoop = true
+		matchup.Points = len(matchup.Rows) + ofcScoopBonus + player.Royalties
+		return matchup
+	}
+
+	won, lost := 0, 0
+	for i := range matchup.Rows {
+		switch compareValues(player.Rows[i].Hand.RankValue, opponent.Rows[i].Hand.RankValue) {
+		case handAhead:
+			matchup.Rows[i] = 1
+			won++
+		case handBehind:
+			matchup.Rows[i] = -1
+			lost++
+		}
+	}
+	matchup.Points = won - lost + player.Royalties - opponent.Royalties
+	if won == len(matchup.Rows) {
+		matchup.Scoop = true
+		matchup.Points += ofcScoopBonus
+	} else if lost == len(matchup.Rows) {
+		matchup.Points -= ofcScoopBonus
+	}
+	return matchup
+}
+
+// validateOFC checks every arrangement has 3, 5 and 5 cards in its rows and
+// that no card is dealt twice
+func validateOFC(arrangements []OFCArrangement) error {
+	seats := make(map[int]bool)
+	dealt := [][]Card{}
+	for _, arrangement := range arrangements {
+		if seats[arrangement.Seat] {
+			return fmt.Errorf("seat %d appears twice", arrangement.Seat)
+		}
+		seats[arrangement.Seat] = true
+
+		for i, cards := range arrangement.rows() {
+			if len(cards) != ofcRowSizes[i] {
+				return fmt.Errorf("seat %d needs %d cards in the %s row, got %d",
+					arrangement.Seat, ofcRowSizes[i], ofcRowNames[i], len(cards))
+			}
+			dealt = append(dealt, cards)
+		}
+	}
+	return checkDistinct(dealt...)
+}
@@ -0,0 +1,144 @@
+package main
+
+import "testing"
+
+// ofcHand builds an arrangement from its three rows
+func ofcHand(t *testing.T, seat int, top, middle, bottom string) OFCArrangement {
+	t.Helper()
+	return OFCArrangement{
+		Seat:   seat,
+		Top:    mustParseHand(t, top),
+		Middle: mustParseHand(t, middle),
+		Bottom: mustParseHand(t, bottom),
+	}
+}
+
+func TestOFCFouls(t *testing.T) {
+	tests := []struct {
+		name                string
+		top, middle, bottom string
+		fouled              bool
+	}{
+		{"top pair below middle kickers", "Qh Qd Ah", "Qc Qs Ad 3h 2c", "Ks Kh Kc 4d 4c", false},
+		{"top kicker above middle kicker", "Qh Qd Ah", "Qc Qs Kd 3h 2c", "Ks Kh Kc 4d 4c", true},
+		{"top pair above middle pair", "Kh Kd 2h", "Qc Qs Ad 3h 2c", "As Ah Ac 4d 4c", true},
+		{"top high card above middle", "Ah Kd Qh", "As Kc Jd 3h 2c", "9s 9h 9c 4d 4c", true},
+		{"top trips below middle straight", "2h 2d 2c", "5s 6h 7c 8d 9c", "Ks Kh Kc 4d 4c", false},
+		{"middle above bottom", "2h 3d 4c", "5s 6h 7c 8d 9c", "Ks Kh Qc Qd 4c", true},
+	}
+	for _, tt := range tests {
+		result := scoreArrangement(ofcHand(t, 1, tt.top, tt.middle, tt.bottom))
+		if result.Fouled != tt.fouled {
+			t.Errorf("%s: fouled %v, want %v", tt.name, result.Fouled, tt.fouled)
+		}
+	}
+}
+
+func TestOFCRoyalties(t *testing.T) {
+	tests := []struct {
+		row   int
+		cards string
+		want  int
+	}{
+		{0, "5h 5d Ac", 0},
+		{0, "6h 6d 2c", 1},
+		{0, "Ah Ad Kc", 9},
+		{0, "2h 2d 2c", 10},
+		{0, "Ah Ad Ac", 22},
+		{0, "Ah Kd Qc", 0},
+		{1, "9h 9d 9c 4s 2h", 2},
+		{1, "5s 6h 7c 8d 9c", 4},
+		{1, "2h 5h 7h 9h Jh", 8},
+		{1, "9h 9d 9c 4s 4h", 12},
+		{1, "9h 9d 9c 9s 4h", 20},
+		{1, "5h 6h 7h 8h 9h", 30},
+		{1, "Th Jh Qh Kh Ah", 50},
+		{2, "Qh Qd 9c 4s 2h", 0},
+		{2, "5s 6h 7c 8d 9c", 2},
+		{2, "2h 5h 7h 9h Jh", 4},
+		{2, "9h 9d 9c 4s 4h", 6},
+		{2, "9h 9d 9c 9s 4h", 10},
+		{2, "5h 6h 7h 8h 9h", 15},
+		{2, "Th Jh Qh Kh Ah", 25},
+	}
+	for _, tt := range tests {
+		cards := mustParseHand(t, tt.cards)
+		hand := EvaluateTopRow(cards)
+		if tt.row > 0 {
+			hand = evaluateFiveCards(cards)
+		}
+		if got := rowRoyalty(tt.row, hand); got != tt.want {
+			t.Errorf("%s row %s: royalty %d, want %d", ofcRowNames[tt.row], tt.cards, got, tt.want)
+		}
+	}
+}
+
+func TestOFCFantasyland(t *testing.T) {
+	tests := []struct {
+		top  string
+		want int
+	}{
+		{"Jh Jd Ac", 0},
+		{"Qh Qd 2c", 14},
+		{"Kh Kd 2c", 15},
+		{"Ah Ad 2c", 16},
+		{"2h 2d 2c", 17},
+		{"Ah Kd Qc", 0},
+	}
+	for _, tt := range tests {
+		if got := fantasylandCards(EvaluateTopRow(mustParseHand(t, tt.top))); got != tt.want {
+			t.Errorf("%s: %d Fantasyland cards, want %d", tt.top, got, tt.want)
+		}
+	}
+
+	// A fouled hand never qualifies
+	fouled := scoreArrangement(ofcHand(t, 1, "Qh Qd 2h", "Jc Js Ad 3h 2c", "Ks Kh Kc 4d 4c"))
+	if !fouled.Fouled || fouled.Fantasyland || fouled.FantasylandCards != 0 {
+		t.Errorf("fouled hand: fouled %v, Fantasyland %v with %d cards", fouled.Fouled, fouled.Fantasyland, fouled.FantasylandCards)
+	}
+}
+
+func TestScoreOFC(t *testing.T) {
+	// Seat 1 wins every row with 25 in royalties: QQ on top (7), a flush in
+	// the middle (8) and quads at the bottom (10). Seat 2 has a full house
+	// at the bottom (6).
+	strong := ofcHand(t, 1, "Qh Qd 2c", "3s 4s 5s 6s 8s", "Ks Kh Kc Kd 2d")
+	weak := ofcHand(t, 2, "Jh 7d 3c", "Ah Ac 5d 6c 7c", "Ts Th Tc 8d 8c")
+	results, err := ScoreOFC([]OFCArrangement{strong, weak})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if results[0].Royalties != 25 || results[1].Royalties != 6 {
+		t.Errorf("royalties %d and %d, want 25 and 6", results[0].Royalties, results[1].Royalties)
+	}
+	matchup := results[0].Matchups[0]
+	if !matchup.Scoop || matchup.Points != 3+ofcScoopBonus+25-6 {
+		t.Errorf("scoop %v for %d points, want a scoop for %d", matchup.Scoop, matchup.Points, 3+ofcScoopBonus+25-6)
+	}
+	if results[0].Points != -results[1].Points {
+		t.Errorf("points %d and %d do not balance", results[0].Points, results[1].Points)
+	}
+
+	// Both fouled: nothing changes hands
+	foul1 := ofcHand(t, 1, "Ah Ad 3c", "Kh Kd 4c 5c 7d", "Qs Qh Qc 2d 2s")
+	foul2 := ofcHand(t, 2, "Jh Jd 3d", "Th Td 4d 5d 7h", "9s 9h 9c 8d 8s")
+	results, err = ScoreOFC([]OFCArrangement{foul1, foul2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, result := range results {
+		if !result.Fouled || result.Points != 0 || result.Royalties != 0 {
+			t.Errorf("seat %d: fouled %v, %d points, %d royalties", result.Seat, result.Fouled, result.Points, result.Royalties)
+		}
+	}
+
+	// A fouled hand loses every row, the scoop bonus and the royalties
+	matchup = settleOFC(scoreArrangement(strong), scoreArrangement(foul2))
+	if !matchup.Scoop || matchup.Points != 3+ofcScoopBonus+25 {
+		t.Errorf("against a foul: scoop %v for %d points, want %d", matchup.Scoop, matchup.Points, 3+ofcScoopBonus+25)
+	}
+
+	if _, err := ScoreOFC([]OFCArrangement{strong, strong}); err == nil {
+		t.Error("accepted the same seat and cards twice")
+	}
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+)
+
+// rankNames are the plural names of ranks used in hand labels
+var rankNames = map[int]string{
+	2: "Deuces", 3: "Threes", 4: "Fours", 5: "Fives", 6: "Sixes", 7: "Sevens", 8: "Eights",
+	9: "Nines", 10: "Tens", 11: "Jacks", 12: "Queens", 13: "Kings", 14: "Aces",
+}
+
+// EvaluatePartialHand evaluates fewer than five cards. Only pairs, two pair,
+// trips and quads can be made; straights and flushes need five cards. Values
+// use the same scale as complete hands.
+func EvaluatePartialHand(cards []Card) EvaluatedHand {
+	sorted := append([]Card{}, cards...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Rank > sorted[j].Rank
+	})
+	if len(sorted) == 0 {
+		return EvaluatedHand{Rank: HighCard, Cards: sorted}
+	}
+
+	ranks := groupedRanks(sorted)
+	counts := make(map[int]int)
+	for _, card := range sorted {
+		counts[card.Rank]++
+	}
+	kicker := func(i int) int32 {
+		if i < len(ranks) {
+			return int32(ranks[i])
+		}
+		return 0
+	}
+
+	hand := EvaluatedHand{Cards: sorted}
+	switch {
+	case counts[ranks[0]] == 4:
+		hand.Rank = FourOfAKind
+		hand.RankValue = int32(FourOfAKind)*10000000 + int32(ranks[0])*100000
+	case counts[ranks[0]] == 3:
+		hand.Rank = ThreeOfAKind
+		hand.RankValue = int32(ThreeOfAKind)*10000000 + int32(ranks[0])*100000
+	case counts[ranks[0]] == 2 && len(ranks) > 1 && counts[ranks[1]] == 2:
+		hand.Rank = TwoPair
+		hand.RankValue = int32(TwoPair)*10000000 + int32(ranks[0])*100000 + int32(ranks[1])*1000
+	case counts[ranks[0]] == 2:
+		hand.Rank = OnePair
+		hand.RankValue = int32(OnePair)*10000000 + int32(ranks[0])*100000 +
+			kicker(1)*225 + kicker(2)*15
+	default:
+		hand.Rank = HighCard
+		hand.RankValue = int32(HighCard)*10000000 + highCardValue(sorted)
+	}
+	return hand
+}
+
+// PreflopLabel names a two-card starting hand, e.g. "Pocket Aces" or
+// "Suited Connectors"
+func PreflopLabel(holeCards []Card) string {
+	if len(holeCards) != 2 {
+		return ""
+	}
+	high, low := holeCards[0], holeCards[1]
+	if low.Rank > high.Rank {
+		high, low = low, high
+	}
+	if high.Rank == low.Rank {
+		return "Pocket " + rankNames[high.Rank]
+	}
+
+	suited := "Offsuit"
+	if high.Suit == low.Suit {
+		suited = "Suited"
+	}
+	gap := high.Rank - low.Rank - 1
+	if high.Rank == 14 && low.Rank <= 5 {
+		gap = low.Rank - 2 // The ace also plays low
+	}
+
+	switch {
+	case low.Rank >= 10:
+		return suited + " Broadway"
+	case gap == 0:
+		return suited + " Connectors"
+	case gap == 1:
+		return suited + " One-Gappers"
+	case high.Rank == 14:
+		return suited + " Ace"
+	}
+	return fmt.Sprintf("%s %s-High", suited, RankToString(high.Rank))
+}
+
+// PreflopPercentile returns the percentage of starting hands with lower
+// preflop equity than hero's, counting hands of the same class as half
+func PreflopPercentile(holeCards []Card) float64 {
+	if len(holeCards) != 2 {
+		return 0
+	}
+	class := comboClass(Combo{holeCards[0], holeCards[1]})
+
+	better, same := 0, 0
+	for _, entry := range preflopEquities {
+		combos := len(mustParseRangeToken(entry.Class))
+		if entry.Class == class {
+			same = combos
+			break
+		}
+		better += combos
+	}
+	worse := totalCombos - better - same
+	return 100 * (float64(worse) + float64(same)/2) / totalCombos
+}
+
+// mustParseRangeToken expands a hand class that is known to be valid
+func mustParseRangeToken(token string) []Combo {
+	combos, err := parseRangeToken(token)
+	if err != nil {
+		panic(err)
+	}
+	return combos
+}
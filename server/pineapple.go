@@ -0,0 +1,77 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Pineapple games deal three hole cards; each player discards one and plays
+// the other two as in Hold'em
+const (
+	GamePineapple      = "pineapple"       // Discard before the flop
+	GameCrazyPineapple = "crazy-pineapple" // Discard after the flop
+)
+
+// discardBoards is how many community cards are out when each game discards
+var discardBoards = map[string]int{
+	GamePineapple:      0,
+	GameCrazyPineapple: 3,
+}
+
+// DiscardOption is the equity of keeping two of the three hole cards
+type DiscardOption struct {
+	Discard Card
+	Keep    []Card
+	Result  SimulationResult
+}
+
+// Equity is the option's share of the pot against one random hand
+func (o DiscardOption) Equity() float64 {
+	return o.Result.Win + o.Result.Tie/2
+}
+
+// RecommendDiscard simulates each way of keeping two of three hole cards
+// against a random opponent and returns the options, best first. The board
+// may be empty, or in Crazy Pineapple hold the flop. The discard is dead, so
+// it is never dealt to the board or the opponent.
+func RecommendDiscard(holeCards []Card, communityCards []Card, game string, numSimulations int, estimator Estimator) ([]DiscardOption, error) {
+	if game == "" {
+		game = GamePineapple
+	}
+	boardSize, ok := discardBoards[game]
+	if !ok {
+		return nil, fmt.Errorf("unknown game: %s", game)
+	}
+	if len(holeCards) != 3 {
+		return nil, fmt.Errorf("need exactly 3 hole cards, got %d", len(holeCards))
+	}
+	if len(communityCards) != 0 && len(communityCards) != boardSize {
+		if boardSize == 0 {
+			return nil, fmt.Errorf("%s discards before the flop, got %d community cards", game, len(communityCards))
+		}
+		return nil, fmt.Errorf("%s discards with %d or no community cards, got %d", game, boardSize, len(communityCards))
+	}
+	if err := checkDistinct(holeCards, communityCards); err != nil {
+		return nil, err
+	}
+
+	options := make([]DiscardOption, 0, len(holeCards))
+	for i, discard := range holeCards {
+		keep := []Card{}
+		for j, card := range holeCards {
+			if j != i {
+				keep = append(keep, card)
+			}
+		}
+		options = append(options, DiscardOption{
+			Discard: discard,
+			Keep:    keep,
+			Result:  RunSimulation(keep, communityCards, []Card{discard}, numSimulations, estimator),
+		})
+	}
+
+	sort.SliceStable(options, func(i, j int) bool {
+		return options[i].Equity() > options[j].Equity()
+	})
+	return options, nil
+}
@@ -0,0 +1,178 @@
+package main
+
+// preflopEquities lists the 169 starting hand classes by all-in equity against
+// one random hand, best first. Used to build "top N%" ranges.
+var preflopEquities = []struct {
+	Class  string
+	Equity float64
+}{
+	{"AA", 0.8517},
+	{"KK", 0.8235},
+	{"QQ", 0.7998},
+	{"JJ", 0.7746},
+	{"TT", 0.7504},
+	{"99", 0.7207},
+	{"88", 0.6917},
+	{"AKs", 0.6703},
+	{"77", 0.6621},
+	{"AQs", 0.6620},
+	{"AJs", 0.6542},
+	{"AKo", 0.6533},
+	{"ATs", 0.6460},
+	{"AQo", 0.6442},
+	{"AJo", 0.6353},
+	{"KQs", 0.6341},
+	{"66", 0.6326},
+	{"A9s", 0.6280},
+	{"ATo", 0.6270},
+	{"KJs", 0.6258},
+	{"A8s", 0.6195},
+	{"KTs", 0.6179},
+	{"KQo", 0.6149},
+	{"A7s", 0.6098},
+	{"A9o", 0.6078},
+	{"KJo", 0.6060},
+	{"55", 0.6032},
+	{"QJs", 0.6027},
+	{"K9s", 0.6001},
+	{"A6s", 0.5990},
+	{"A5s", 0.5987},
+	{"A8o", 0.5986},
+	{"KTo", 0.5969},
+	{"QTs", 0.5950},
+	{"A4s", 0.5901},
+	{"A7o", 0.5885},
+	{"K8s", 0.5824},
+	{"A3s", 0.5824},
+	{"QJo", 0.5814},
+	{"K9o", 0.5785},
+	{"A5o", 0.5771},
+	{"Q9s", 0.5767},
+	{"A6o", 0.5765},
+	{"K7s", 0.5755},
+	{"JTs", 0.5748},
+	{"A2s", 0.5734},
+	{"QTo", 0.5728},
+	{"44", 0.5703},
+	{"A4o", 0.5670},
+	{"K6s", 0.5666},
+	{"K8o", 0.5605},
+	{"Q8s", 0.5604},
+	{"A3o", 0.5587},
+	{"K5s", 0.5579},
+	{"J9s", 0.5569},
+	{"Q9o", 0.5533},
+	{"JTo", 0.5521},
+	{"K7o", 0.5518},
+	{"K4s", 0.5493},
+	{"A2o", 0.5491},
+	{"Q7s", 0.5433},
+	{"K6o", 0.5420},
+	{"K3s", 0.5406},
+	{"T9s", 0.5404},
+	{"J8s", 0.5401},
+	{"33", 0.5370},
+	{"Q6s", 0.5362},
+	{"Q8o", 0.5361},
+	{"K5o", 0.5330},
+	{"J9o", 0.5325},
+	{"K2s", 0.5320},
+	{"Q5s", 0.5276},
+	{"K4o", 0.5233},
+	{"T8s", 0.5231},
+	{"J7s", 0.5226},
+	{"Q4s", 0.5181},
+	{"Q7o", 0.5175},
+	{"T9o", 0.5150},
+	{"J8o", 0.5150},
+	{"K3o", 0.5139},
+	{"Q6o", 0.5099},
+	{"Q3s", 0.5098},
+	{"98s", 0.5081},
+	{"T7s", 0.5065},
+	{"J6s", 0.5061},
+	{"K2o", 0.5055},
+	{"22", 0.5034},
+	{"Q2s", 0.5020},
+	{"Q5o", 0.5009},
+	{"J5s", 0.4999},
+	{"T8o", 0.4974},
+	{"J7o", 0.4966},
+	{"Q4o", 0.4915},
+	{"97s", 0.4913},
+	{"J4s", 0.4911},
+	{"T6s", 0.4899},
+	{"Q3o", 0.4825},
+	{"J3s", 0.4821},
+	{"98o", 0.4807},
+	{"87s", 0.4793},
+	{"T7o", 0.4791},
+	{"J6o", 0.4789},
+	{"96s", 0.4748},
+	{"J2s", 0.4737},
+	{"Q2o", 0.4727},
+	{"T5s", 0.4719},
+	{"J5o", 0.4715},
+	{"T4s", 0.4655},
+	{"97o", 0.4627},
+	{"86s", 0.4625},
+	{"J4o", 0.4614},
+	{"T6o", 0.4613},
+	{"95s", 0.4574},
+	{"T3s", 0.4568},
+	{"76s", 0.4538},
+	{"J3o", 0.4528},
+	{"87o", 0.4504},
+	{"T2s", 0.4486},
+	{"85s", 0.4455},
+	{"96o", 0.4442},
+	{"J2o", 0.4436},
+	{"T5o", 0.4426},
+	{"94s", 0.4385},
+	{"75s", 0.4364},
+	{"T4o", 0.4352},
+	{"93s", 0.4325},
+	{"86o", 0.4323},
+	{"65s", 0.4312},
+	{"84s", 0.4269},
+	{"95o", 0.4266},
+	{"T3o", 0.4256},
+	{"92s", 0.4240},
+	{"76o", 0.4235},
+	{"74s", 0.4182},
+	{"T2o", 0.4170},
+	{"54s", 0.4151},
+	{"85o", 0.4141},
+	{"64s", 0.4131},
+	{"83s", 0.4085},
+	{"94o", 0.4067},
+	{"75o", 0.4049},
+	{"82s", 0.4030},
+	{"93o", 0.4003},
+	{"73s", 0.4003},
+	{"65o", 0.3998},
+	{"53s", 0.3971},
+	{"63s", 0.3952},
+	{"84o", 0.3946},
+	{"92o", 0.3905},
+	{"43s", 0.3863},
+	{"74o", 0.3857},
+	{"54o", 0.3814},
+	{"72s", 0.3810},
+	{"64o", 0.3802},
+	{"52s", 0.3786},
+	{"62s", 0.3769},
+	{"83o", 0.3750},
+	{"42s", 0.3687},
+	{"82o", 0.3682},
+	{"73o", 0.3667},
+	{"53o", 0.3628},
+	{"63o", 0.3617},
+	{"32s", 0.3602},
+	{"43o", 0.3519},
+	{"72o", 0.3462},
+	{"52o", 0.3426},
+	{"62o", 0.3406},
+	{"42o", 0.3323},
+	{"32o", 0.3233},
+}
@@ -0,0 +1,935 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"strings"
+	"time"
+)
+
+const (
+	// maxExactQueryDeals is the most deals a query is enumerated over before
+	// the automatic method switches to Monte Carlo
+	maxExactQueryDeals = 3000000
+
+	// defaultQuerySimulations is the number of deals sampled by default
+	defaultQuerySimulations = 200000
+)
+
+// QueryMethod selects how a probability query is evaluated
+type QueryMethod int
+
+const (
+	QueryAuto QueryMethod = iota
+	QueryExact
+	QueryMonteCarlo
+)
+
+// QueryResult is the answer to a probability query
+type QueryResult struct {
+	Probability float64
+	Hits        float64 // Deals where the event held
+	Deals       float64 // Deals where the conditions held
+	Method      QueryMethod
+	StdError    float64 // 0 when exact
+}
+
+// cardPattern matches cards by rank, suit or both; zero values match anything
+type cardPattern struct {
+	Rank int
+	Suit string
+}
+
+// matches reports whether a card fits the pattern
+func (p cardPattern) matches(card Card) bool {
+	return (p.Rank == 0 || p.Rank == card.Rank) && (p.Suit == "" || p.Suit == card.Suit)
+}
+
+// cardItem is one card of a condition: a known card, a pattern, or a free
+// slot when both are empty
+type cardItem struct {
+	card    Card
+	known   bool
+	pattern cardPattern
+}
+
+// queryGroup is a set of cards dealt together: a player's hole cards or a street
+type queryGroup struct {
+	size     int
+	fixed    []Card
+	patterns []cardPattern // Unknown cards must match these, one card each
+}
+
+// unknown returns how many cards of the group are dealt at random
+func (g *queryGroup) unknown() int {
+	return g.size - len(g.fixed)
+}
+
+// queryDeal is one complete deal an event is judged on
+type queryDeal struct {
+	hero     []Card
+	opponent []Card
+	board    []Card
+}
+
+// queryEvent reports whether something happened in a deal
+type queryEvent func(deal *queryDeal) bool
+
+// query is a parsed probability question
+type query struct {
+	event     queryEvent
+	condition queryEvent // nil when there are only card conditions
+	hero      queryGroup
+	opponent  queryGroup
+	board     []queryGroup // Flop, turn and river, or one merged group
+	dead      []Card
+}
+
+// EvaluateQuery answers a probability question such as
+// "P(flush or better by river | hole=AhKh)". Events can be combined with
+// and, or, not and parentheses; conditions after "|" are card assignments
+// (hole=, opponent=, flop=, turn=, river=, board=, dead=) or further events.
+func EvaluateQuery(text string, method QueryMethod, numSimulations int) (QueryResult, error) {
+	q, err := parseQuery(text)
+	if err != nil {
+		return QueryResult{}, err
+	}
+
+	deals := q.exactDeals()
+	if method == QueryAuto {
+		method = QueryExact
+		if deals > maxExactQueryDeals {
+			method = QueryMonteCarlo
+		}
+	}
+
+	var result QueryResult
+	if method == QueryExact {
+		if deals > 10*maxExactQueryDeals {
+			return QueryResult{}, fmt.Errorf("too many deals to enumerate exactly: %.0f", deals)
+		}
+		result = q.enumerate()
+	} else {
+		if numSimulations <= 0 {
+			numSimulations = defaultQuerySimulations
+		}
+		result = q.sample(numSimulations)
+	}
+	result.Method = method
+
+	if result.Deals == 0 {
+		return QueryResult{}, fmt.Errorf("the conditions can never hold")
+	}
+	result.Probability = result.Hits / result.Deals
+	if method == QueryMonteCarlo {
+		p := result.Probability
+		result.StdError = math.Sqrt(p * (1 - p) / result.Deals)
+	}
+	return result, nil
+}
+
+// groups lists every group of the query in dealing order
+func (q *query) groups() []*queryGroup {
+	groups := []*queryGroup{&q.hero, &q.opponent}
+	for i := range q.board {
+		groups = append(groups, &q.board[i])
+	}
+	return groups
+}
+
+// fixedCards returns every card whose identity is known
+func (q *query) fixedCards() []Card {
+	cards := append([]Card{}, q.dead...)
+	for _, group := range q.groups() {
+		cards = append(cards, group.fixed...)
+	}
+	return cards
+}
+
+// exactDeals estimates the deals an exact evaluation would visit
+func (q *query) exactDeals() float64 {
+	pool := remainingDeck(q.fixedCards())
+	remaining := len(pool)
+	deals := 1.0
+	for _, group := range q.groups() {
+		for _, pattern := range group.patterns {
+			candidates := 0
+			for _, card := range pool {
+				if pattern.matches(card) {
+					candidates++
+				}
+			}
+			deals *= float64(candidates)
+		}
+		free := group.unknown() - len(group.patterns)
+		deals *= binomial(remaining-len(group.patterns), free)
+		remaining -= group.unknown()
+	}
+	return deals
+}
+
+// binomial returns n choose k
+func binomial(n, k int) float64 {
+	if k < 0 || k > n {
+		return 0
+	}
+	result := 1.0
+	for i := 0; i < k; i++ {
+		result = result * float64(n-i) / float64(i+1)
+	}
+	return result
+}
+
+// judge checks one complete deal, counted with a weight, against the
+// conditions and the event
+func (q *query) judge(deal *queryDeal, weight float64, result *QueryResult) {
+	if q.condition != nil && !q.condition(deal) {
+		return
+	}
+	result.Deals += weight
+	if q.event(deal) {
+		result.Hits += weight
+	}
+}
+
+// assign copies a group's cards into the deal
+func (q *query) assign(deal *queryDeal, group int, cards []Card) {
+	switch group {
+	case 0:
+		deal.hero = cards
+	case 1:
+		deal.opponent = cards
+	default:
+		offset := 0
+		for i := 0; i < group-2; i++ {
+			offset += q.board[i].size
+		}
+		copy(deal.board[offset:], cards)
+	}
+}
+
+// newDeal returns a deal with room for the board
+func (q *query) newDeal() *queryDeal {
+	size := 0
+	for _, group := range q.board {
+		size += group.size
+	}
+	return &queryDeal{board: make([]Card, size)}
+}
+
+// enumerate visits every deal consistent with the card conditions. Cards
+// for patterns are picked first; a deal reachable through several pattern
+// assignments is weighted down so that it counts once.
+func (q *query) enumerate() QueryResult {
+	var result QueryResult
+	groups := q.groups()
+	pool := remainingDeck(q.fixedCards())
+	used := make([]bool, len(pool))
+	deal := q.newDeal()
+
+	var dealGroup func(g int, weight float64)
+	dealGroup = func(g int, weight float64) {
+		if g == len(groups) {
+			q.judge(deal, weight, &result)
+			return
+		}
+		group := groups[g]
+		cards := make([]Card, group.size)
+		copy(cards, group.fixed)
+		picked := cards[len(group.fixed):]
+
+		// Free cards walk combinations of unused pool cards in index order
+		var pickFree func(slot, start int)
+		pickFree = func(slot, start int) {
+			if slot == len(picked) {
+				q.assign(deal, g, cards)
+				dealGroup(g+1, weight/float64(countMatchings(group.patterns, picked)))
+				return
+			}
+			for i := start; i < len(pool); i++ {
+				if !used[i] {
+					used[i] = true
+					picked[slot] = pool[i]
+					pickFree(slot+1, i+1)
+					used[i] = false
+				}
+			}
+		}
+
+		var pickPattern func(slot int)
+		pickPattern = func(slot int) {
+			if slot == len(group.patterns) {
+				pickFree(slot, 0)
+				return
+			}
+			for i, card := range pool {
+				if !used[i] && group.patterns[slot].matches(card) {
+					used[i] = true
+					picked[slot] = card
+					pickPattern(slot + 1)
+					used[i] = false
+				}
+			}
+		}
+		pickPattern(0)
+	}
+	dealGroup(0, 1)
+
+	return result
+}
+
+// sample draws random deals, rejecting those that miss a card pattern
+func (q *query) sample(numSimulations int) QueryResult {
+	var result QueryResult
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	groups := q.groups()
+	pool := remainingDeck(q.fixedCards())
+	deal := q.newDeal()
+
+	needed := 0
+	for _, group := range groups {
+		needed += group.unknown()
+	}
+
+	for sim := 0; sim < numSimulations; sim++ {
+		for j := 0; j < needed; j++ {
+			k := j + rng.Intn(len(pool)-j)
+			pool[j], pool[k] = pool[k], pool[j]
+		}
+
+		offset := 0
+		matched := true
+		for g, group := range groups {
+			picked := pool[offset : offset+group.unknown()]
+			offset += group.unknown()
+			if !matchPatterns(group.patterns, picked) {
+				matched = false
+				break
+			}
+			q.assign(deal, g, append(append([]Card{}, group.fixed...), picked...))
+		}
+		if matched {
+			q.judge(deal, 1, &result)
+		}
+	}
+
+	return result
+}
+
+// matchPatterns reports whether each pattern can be given its own card
+func matchPatterns(patterns []cardPattern, cards []Card) bool {
+	return len(patterns) == 0 || countMatchings(patterns, cards) > 0
+}
+
+// countMatchings counts the ways to give each pattern its own matching card
+func countMatchings(patterns []cardPattern, cards []Card) int {
+	if len(patterns) == 0 {
+		return 1
+	}
+	taken := make([]bool, len(cards))
+	var count func(p int) int
+	count = func(p int) int {
+		if p == len(patterns) {
+			return 1
+		}
+		total := 0
+		for i, card := range cards {
+			if !taken[i] && patterns[p].matches(card) {
+				taken[i] = true
+				total += count(p + 1)
+				taken[i] = false
+			}
+		}
+		return total
+	}
+	return count(0)
+}
+
+// queryParser parses the event language one token at a time
+type queryParser struct {
+	tokens []string
+	pos    int
+
+	usesHero     bool
+	usesOpponent bool
+	boardSizes   map[int]bool // Board sizes events look at
+}
+
+// parseQuery parses "P(event | conditions)"
+func parseQuery(text string) (*query, error) {
+	text = strings.TrimSpace(text)
+	lower := strings.ToLower(text)
+	if !strings.HasPrefix(lower, "p(") || !strings.HasSuffix(lower, ")") {
+		return nil, fmt.Errorf("query must look like P(event | conditions): %q", text)
+	}
+	body := text[2 : len(text)-1]
+
+	eventText, conditionText := body, ""
+	if idx := strings.Index(body, "|"); idx >= 0 {
+		eventText, conditionText = body[:idx], body[idx+1:]
+	}
+
+	parser := &queryParser{boardSizes: make(map[int]bool)}
+	q := &query{
+		hero:     queryGroup{size: 2},
+		opponent: queryGroup{size: 2},
+	}
+
+	event, err := parser.parseEvents(eventText)
+	if err != nil {
+		return nil, err
+	}
+	q.event = event
+
+	// Card assignments by street, before any merging
+	streets := []queryGroup{{size: 3}, {size: 1}, {size: 1}}
+	streetGiven := []bool{false, false, false}
+	seen := make(map[string]bool)
+
+	for _, part := range strings.Split(conditionText, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		key, value, isCards := strings.Cut(part, "=")
+		if !isCards {
+			condition, err := parser.parseEvents(part)
+			if err != nil {
+				return nil, err
+			}
+			if q.condition == nil {
+				q.condition = condition
+			} else {
+				q.condition = andEvents(q.condition, condition)
+			}
+			continue
+		}
+
+		key = strings.ToLower(strings.TrimSpace(key))
+		if seen[key] {
+			return nil, fmt.Errorf("condition %s given twice", key)
+		}
+		seen[key] = true
+		items, err := parseCardItems(strings.TrimSpace(value))
+		if err != nil {
+			return nil, fmt.Errorf("condition %s: %v", key, err)
+		}
+
+		switch key {
+		case "hole", "hero":
+			parser.usesHero = true
+			err = q.hero.place(items)
+		case "opponent", "villain":
+			parser.usesOpponent = true
+			err = q.opponent.place(items)
+		case "flop", "turn", "river":
+			street := map[string]int{"flop": 0, "turn": 1, "river": 2}[key]
+			streetGiven[street] = true
+			err = streets[street].place(items)
+		case "board":
+			err = placeBoard(streets, streetGiven, items)
+		case "dead":
+			for _, item := range items {
+				if !item.known {
+					return nil, fmt.Errorf("condition dead: cards must be exact")
+				}
+				q.dead = append(q.dead, item.card)
+			}
+		default:
+			return nil, fmt.Errorf("unknown condition %q", key)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("condition %s: %v", key, err)
+		}
+	}
+
+	if !parser.usesHero {
+		q.hero.size = 0
+	}
+	if !parser.usesOpponent {
+		q.opponent.size = 0
+	}
+
+	// Deal the board up to the last street an event looks at or a condition names
+	boardSize := 0
+	for size := range parser.boardSizes {
+		if size > boardSize {
+			boardSize = size
+		}
+	}
+	for street, given := range streetGiven {
+		if given && streetBoardSizes[street+1].Cards > boardSize {
+			boardSize = streetBoardSizes[street+1].Cards
+		}
+	}
+	for street := range streets {
+		if streetBoardSizes[street+1].Cards <= boardSize {
+			q.board = append(q.board, streets[street])
+		}
+	}
+
+	// When events only look at the whole board, the order of its cards does
+	// not matter and the streets can be dealt as one group
+	wholeBoard := true
+	for size := range parser.boardSizes {
+		if size != 0 && size != boardSize {
+			wholeBoard = false
+		}
+	}
+	if wholeBoard && len(q.board) > 1 {
+		merged := queryGroup{}
+		for _, group := range q.board {
+			merged.size += group.size
+			merged.fixed = append(merged.fixed, group.fixed...)
+			merged.patterns = append(merged.patterns, group.patterns...)
+		}
+		q.board = []queryGroup{merged}
+	}
+
+	if err := checkDistinct(q.fixedCards()); err != nil {
+		return nil, err
+	}
+
+	return q, nil
+}
+
+// place puts the cards of a condition into a group
+func (g *queryGroup) place(items []cardItem) error {
+	if len(g.fixed)+len(g.patterns)+len(items) > g.size {
+		return fmt.Errorf("at most %d cards, got %d", g.size, len(items))
+	}
+	for _, item := range items {
+		if item.known {
+			g.fixed = append(g.fixed, item.card)
+		} else if item.pattern != (cardPattern{}) {
+			g.patterns = append(g.patterns, item.pattern)
+		}
+	}
+	return nil
+}
+
+// placeBoard spreads board cards over the flop, turn and river in order
+func placeBoard(streets []queryGroup, given []bool, items []cardItem) error {
+	if len(items) > 5 {
+		return fmt.Errorf("at most 5 cards, got %d", len(items))
+	}
+	start := 0
+	for street := range streets {
+		end := start + streets[street].size
+		if end > len(items) {
+			end = len(items)
+		}
+		if start < end {
+			if given[street] {
+				return fmt.Errorf("%s given twice", strings.ToLower(streetBoardSizes[street+1].Street))
+			}
+			given[street] = true
+			if err := streets[street].place(items[start:end]); err != nil {
+				return err
+			}
+		}
+		start += streets[street].size
+	}
+	return nil
+}
+
+// parseEvents parses a full event expression
+func (p *queryParser) parseEvents(text string) (queryEvent, error) {
+	p.tokens = tokenizeQuery(text)
+	p.pos = 0
+	if len(p.tokens) == 0 {
+		return nil, fmt.Errorf("missing event")
+	}
+	event, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, fmt.Errorf("unexpected %q in %q", p.tokens[p.pos], text)
+	}
+	return event, nil
+}
+
+// tokenizeQuery splits an event into lowercase words and parentheses
+func tokenizeQuery(text string) []string {
+	text = strings.NewReplacer("(", " ( ", ")", " ) ").Replace(text)
+	return strings.Fields(strings.ToLower(text))
+}
+
+// peek returns the next token, or "" at the end
+func (p *queryParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+// accept consumes the next tokens if they match words
+func (p *queryParser) accept(words ...string) bool {
+	if p.pos+len(words) > len(p.tokens) {
+		return false
+	}
+	for i, word := range words {
+		if p.tokens[p.pos+i] != word {
+			return false
+		}
+	}
+	p.pos += len(words)
+	return true
+}
+
+// parseOr parses events joined by "or"
+func (p *queryParser) parseOr() (queryEvent, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.accept("or") {
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = orEvents(left, right)
+	}
+	return left, nil
+}
+
+// parseAnd parses events joined by "and"
+func (p *queryParser) parseAnd() (queryEvent, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.accept("and") {
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = andEvents(left, right)
+	}
+	return left, nil
+}
+
+// parseNot parses a negated, parenthesized or simple event
+func (p *queryParser) parseNot() (queryEvent, error) {
+	if p.accept("not") {
+		event, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return func(deal *queryDeal) bool { return !event(deal) }, nil
+	}
+	if p.accept("(") {
+		event, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if !p.accept(")") {
+			return nil, fmt.Errorf("missing closing parenthesis")
+		}
+		return event, nil
+	}
+	return p.parseAtom()
+}
+
+// andEvents holds when both events hold
+func andEvents(a, b queryEvent) queryEvent {
+	return func(deal *queryDeal) bool { return a(deal) && b(deal) }
+}
+
+// orEvents holds when either event holds
+func orEvents(a, b queryEvent) queryEvent {
+	return func(deal *queryDeal) bool { return a(deal) || b(deal) }
+}
+
+// parseAtom parses a single event about a player or the board
+func (p *queryParser) parseAtom() (queryEvent, error) {
+	switch {
+	case p.accept("board"):
+		return p.parseBoardEvent()
+	case p.accept("tie"), p.accept("split"), p.accept("chop"):
+		p.usesHero, p.usesOpponent = true, true
+		p.boardSizes[5] = true
+		return func(deal *queryDeal) bool {
+			return showdownValue(deal.hero, deal.board) == showdownValue(deal.opponent, deal.board)
+		}, nil
+	}
+
+	// Subject, hero when omitted
+	opponent := false
+	switch {
+	case p.accept("opponent"), p.accept("villain"), p.accept("they"):
+		opponent = true
+	case p.accept("hero"), p.accept("i"), p.accept("we"):
+	}
+	if opponent {
+		p.usesOpponent = true
+	} else {
+		p.usesHero = true
+	}
+	player := func(deal *queryDeal) []Card {
+		if opponent {
+			return deal.opponent
+		}
+		return deal.hero
+	}
+
+	switch {
+	case p.accept("wins"), p.accept("win"):
+		p.usesHero, p.usesOpponent = true, true
+		p.boardSizes[5] = true
+		return func(deal *queryDeal) bool {
+			hero := showdownValue(deal.hero, deal.board)
+			villain := showdownValue(deal.opponent, deal.board)
+			if opponent {
+				return villain > hero
+			}
+			return hero > villain
+		}, nil
+	case p.accept("holds"), p.accept("hold"):
+		token := p.peek()
+		if token == "" {
+			return nil, fmt.Errorf("missing range after holds")
+		}
+		p.pos++
+		r, err := ParseRange(token)
+		if err != nil {
+			return nil, err
+		}
+		return func(deal *queryDeal) bool {
+			cards := player(deal)
+			return r.Weight(Combo{cards[0], cards[1]}) > 0
+		}, nil
+	}
+
+	for _, verb := range []string{"makes", "make", "has", "have", "hits", "hit", "gets", "get", "flops", "flop"} {
+		if p.accept(verb) {
+			break
+		}
+	}
+
+	rank, ok := p.parseHandName()
+	if !ok {
+		return nil, fmt.Errorf("expected an event, got %q", p.peek())
+	}
+	compare := func(made HandRank) bool { return made == rank }
+	switch {
+	case p.accept("or", "better"):
+		compare = func(made HandRank) bool { return made >= rank }
+	case p.accept("or", "worse"):
+		compare = func(made HandRank) bool { return made <= rank }
+	}
+
+	size, exactly, err := p.parseStreet()
+	if err != nil {
+		return nil, err
+	}
+	p.boardSizes[size] = true
+	previous := previousBoardSize(size)
+	if exactly {
+		p.boardSizes[previous] = true
+	}
+
+	return func(deal *queryDeal) bool {
+		cards := player(deal)
+		if !compare(handCategory(cards, deal.board[:size])) {
+			return false
+		}
+		return !exactly || !compare(handCategory(cards, deal.board[:previous]))
+	}, nil
+}
+
+// parseBoardEvent parses what follows "board", e.g. "pairs on turn"
+func (p *queryParser) parseBoardEvent() (queryEvent, error) {
+	p.accept("is")
+
+	var test func(texture BoardTexture) bool
+	switch {
+	case p.accept("pairs"), p.accept("paired"), p.accept("pair"):
+		test = func(texture BoardTexture) bool { return texture.Paired }
+	case p.accept("trips"):
+		test = func(texture BoardTexture) bool { return texture.Trips }
+	case p.accept("monotone"):
+		test = func(texture BoardTexture) bool { return texture.SuitPattern == Monotone }
+	case p.accept("rainbow"):
+		test = func(texture BoardTexture) bool { return texture.SuitPattern == Rainbow }
+	case p.accept("flush", "possible"):
+		test = func(texture BoardTexture) bool { return texture.FlushPossible }
+	case p.accept("straight", "possible"):
+		test = func(texture BoardTexture) bool { return texture.StraightPossible }
+	default:
+		return nil, fmt.Errorf("unknown board event %q", p.peek())
+	}
+
+	size, exactly, err := p.parseStreet()
+	if err != nil {
+		return nil, err
+	}
+	if size == 0 {
+		return nil, fmt.Errorf("the board is empty preflop")
+	}
+	p.boardSizes[size] = true
+	previous := previousBoardSize(size)
+	if exactly && previous > 0 {
+		p.boardSizes[previous] = true
+	}
+
+	return func(deal *queryDeal) bool {
+		if !test(boardStructure(deal.board[:size])) {
+			return false
+		}
+		return !exactly || previous == 0 || !test(boardStructure(deal.board[:previous]))
+	}, nil
+}
+
+// parseStreet parses an optional "by <street>" or "on <street>", returning
+// the board size and whether the event must happen on that street itself
+func (p *queryParser) parseStreet() (int, bool, error) {
+	exactly := false
+	switch {
+	case p.accept("by"):
+	case p.accept("on"):
+		exactly = true
+	default:
+		return 5, false, nil
+	}
+	name := p.peek()
+	for _, street := range streetBoardSizes {
+		if strings.ToLower(street.Street) == name {
+			p.pos++
+			return street.Cards, exactly, nil
+		}
+	}
+	return 0, false, fmt.Errorf("unknown street %q", name)
+}
+
+// previousBoardSize returns the board size of the street before one
+func previousBoardSize(size int) int {
+	previous := 0
+	for _, street := range streetBoardSizes {
+		if street.Cards < size {
+			previous = street.Cards
+		}
+	}
+	return previous
+}
+
+// queryHandNames maps the hand names of the query language to categories,
+// longer names first so that they win over their prefixes
+var queryHandNames = []struct {
+	words []string
+	rank  HandRank
+}{
+	{[]string{"straight", "flush"}, StraightFlush},
+	{[]string{"four", "of", "a", "kind"}, FourOfAKind},
+	{[]string{"three", "of", "a", "kind"}, ThreeOfAKind},
+	{[]string{"full", "house"}, FullHouse},
+	{[]string{"two", "pair"}, TwoPair},
+	{[]string{"one", "pair"}, OnePair},
+	{[]string{"high", "card"}, HighCard},
+	{[]string{"a", "pair"}, OnePair},
+	{[]string{"a", "flush"}, Flush},
+	{[]string{"a", "straight"}, Straight},
+	{[]string{"a", "set"}, ThreeOfAKind},
+	{[]string{"quads"}, FourOfAKind},
+	{[]string{"boat"}, FullHouse},
+	{[]string{"flush"}, Flush},
+	{[]string{"straight"}, Straight},
+	{[]string{"trips"}, ThreeOfAKind},
+	{[]string{"set"}, ThreeOfAKind},
+	{[]string{"pair"}, OnePair},
+}
+
+// parseHandName parses a hand category name
+func (p *queryParser) parseHandName() (HandRank, bool) {
+	for _, name := range queryHandNames {
+		if p.accept(name.words...) {
+			return name.rank, true
+		}
+	}
+	return HighCard, false
+}
+
+// handCategory returns the category of a player's best hand with the board;
+// before the flop only pairs stand out from high cards
+func handCategory(holeCards []Card, board []Card) HandRank {
+	if len(board) == 0 {
+		if holeCards[0].Rank == holeCards[1].Rank {
+			return OnePair
+		}
+		return HighCard
+	}
+	return HandRank(showdownValue(holeCards, board) / 10000000)
+}
+
+// showdownValue returns the RankValue of a player's best hand with the board
+func showdownValue(holeCards []Card, board []Card) int32 {
+	var cards [7]Card
+	n := copy(cards[:], holeCards)
+	n += copy(cards[n:], board)
+	return handValue(cards[:n])
+}
+
+// parseCardItems parses a run of cards such as "AhKh", "Ks7d2c" or "HAKH",
+// with patterns such as "Ax" (any ace), "xh" (any heart) and "x" (any card)
+func parseCardItems(s string) ([]cardItem, error) {
+	s = strings.ToUpper(strings.ReplaceAll(s, " ", ""))
+	for symbol, letter := range unicodeSuits {
+		s = strings.ReplaceAll(s, symbol, letter)
+	}
+	items := []cardItem{}
+
+	for i := 0; i < len(s); {
+		rankLen := 1
+		if strings.HasPrefix(s[i:], "10") {
+			rankLen = 2
+		}
+		isRank := rankLen == 2 || strings.IndexByte(rangeRanks, s[i]) >= 0
+		isSuit := func(j int) bool { return j < len(s) && strings.IndexByte("HDCS", s[j]) >= 0 }
+
+		switch {
+		case s[i] == 'X':
+			item := cardItem{}
+			i++
+			if isSuit(i) {
+				item.pattern.Suit = s[i : i+1]
+				i++
+			}
+			items = append(items, item)
+		case isRank && i+rankLen < len(s) && s[i+rankLen] == 'X':
+			rank, err := ParseCard("S" + s[i:i+rankLen])
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, cardItem{pattern: cardPattern{Rank: rank.Rank}})
+			i += rankLen + 1
+		case isRank && isSuit(i+rankLen):
+			card, err := ParseCard(s[i+rankLen:i+rankLen+1] + s[i:i+rankLen])
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, cardItem{card: card, known: true})
+			i += rankLen + 1
+		case isSuit(i) && i+1 < len(s):
+			// Suit first, as in "HA" or "D10"
+			rankLen = 1
+			if strings.HasPrefix(s[i+1:], "10") {
+				rankLen = 2
+			}
+			card, err := ParseCard(s[i : i+1+rankLen])
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, cardItem{card: card, known: true})
+			i += 1 + rankLen
+		default:
+			return nil, fmt.Errorf("invalid card at %q", s[i:])
+		}
+	}
+
+	if len(items) == 0 {
+		return nil, fmt.Errorf("no cards given")
+	}
+	return items, nil
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestEvaluateQueryExact(t *testing.T) {
+	tests := []struct {
+		query       string
+		hits, deals float64
+	}{
+		{"P(board pairs on turn | flop=Ks7d2c)", 9, 49},
+		{"P(flush or better by river | hole=AhKh, flop=Qh7h2c)", 378, 1081},
+		{"P(opponent holds AA | hole=KsKd)", 6, 1225},
+		{"P(board pairs on turn | flop=Ks7d2c, turn=Kd)", 1, 1},
+	}
+
+	for _, tt := range tests {
+		result, err := EvaluateQuery(tt.query, QueryAuto, 0)
+		if err != nil {
+			t.Errorf("%s: %v", tt.query, err)
+			continue
+		}
+		if result.Method != QueryExact {
+			t.Errorf("%s: method = %v, want QueryExact", tt.query, result.Method)
+		}
+		if result.Hits != tt.hits || result.Deals != tt.deals {
+			t.Errorf("%s = %v/%v, want %v/%v", tt.query, result.Hits, result.Deals, tt.hits, tt.deals)
+		}
+	}
+}
+
+func TestEvaluateQueryMonteCarloMatchesExact(t *testing.T) {
+	queries := []string{
+		"P(board pairs on turn | flop=Ks7d2c)",
+		"P(flush or better by river | hole=AhKh, flop=Qh7h2c)",
+		"P(opponent holds AA | board=AxxKx)",
+	}
+
+	for _, query := range queries {
+		exact, err := EvaluateQuery(query, QueryExact, 0)
+		if err != nil {
+			t.Fatalf("%s exact: %v", query, err)
+		}
+		sampled, err := EvaluateQuery(query, QueryMonteCarlo, 100000)
+		if err != nil {
+			t.Fatalf("%s sampled: %v", query, err)
+		}
+		if sampled.StdError <= 0 {
+			t.Errorf("%s: sampled result has no standard error", query)
+		}
+		if diff := math.Abs(sampled.Probability - exact.Probability); diff > 5*sampled.StdError+1e-4 {
+			t.Errorf("%s: sampled %.5f, exact %.5f", query, sampled.Probability, exact.Probability)
+		}
+	}
+}
+
+func TestEvaluateQueryErrors(t *testing.T) {
+	queries := []string{
+		"board pairs on turn",
+		"P(board pairs on turn",
+		"P(sky is blue)",
+		"P(board pairs on turn | flop=Ks7d2c, flop=Ah3d4c)",
+		"P(flush by river | hole=Zz9q)",
+		"P(flush by river | hole=AhAh)",
+		"P(flush by river | hole=AhKh, flop=Ah2c3d)",
+	}
+	for _, query := range queries {
+		if _, err := EvaluateQuery(query, QueryAuto, 0); err == nil {
+			t.Errorf("%s: expected an error", query)
+		}
+	}
+}
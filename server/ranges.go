@@ -0,0 +1,708 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Combo is a specific two-card starting hand
+type Combo [2]Card
+
+// Range is a set of combos, each with a weight between 0 and 1
+type Range map[Combo]float64
+
+// totalCombos is the number of two-card starting hands
+const totalCombos = 1326
+
+// rangeRanks lists the rank characters used in range notation, lowest first
+const rangeRanks = "23456789TJQKA"
+
+// rangeSuits is the suit order used when building and sorting combos
+var rangeSuits = []string{"S", "H", "D", "C"}
+
+// NewRange returns an empty range
+func NewRange() Range {
+	return Range{}
+}
+
+// Add sets the weight of a combo, keeping the larger weight if already present
+func (r Range) Add(c Combo, weight float64) {
+	c = canonicalCombo(c)
+	if weight > r[c] {
+		r[c] = weight
+	}
+}
+
+// Weight returns the weight of a combo, 0 when absent
+func (r Range) Weight(c Combo) float64 {
+	return r[canonicalCombo(c)]
+}
+
+// Combos returns the combos in the range, highest hands first
+func (r Range) Combos() []Combo {
+	combos := make([]Combo, 0, len(r))
+	for combo, weight := range r {
+		if weight > 0 {
+			combos = append(combos, combo)
+		}
+	}
+	sort.Slice(combos, func(i, j int) bool {
+		return comboLess(combos[j], combos[i])
+	})
+	return combos
+}
+
+// Size returns the weighted number of combos in the range
+func (r Range) Size() float64 {
+	size := 0.0
+	for _, weight := range r {
+		size += weight
+	}
+	return size
+}
+
+// SuitSymmetric reports whether every combo of a starting hand class has the
+// same weight, so renaming suits leaves the range unchanged
+func (r Range) SuitSymmetric() bool {
+	for _, class := range rangeClasses() {
+		if weight, _ := r.classWeight(class.combos); weight < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Union returns the combos in either range, with the larger weight
+func (r Range) Union(other Range) Range {
+	result := NewRange()
+	for combo, weight := range r {
+		result.Add(combo, weight)
+	}
+	for combo, weight := range other {
+		result.Add(combo, weight)
+	}
+	return result
+}
+
+// Intersect returns the combos in both ranges, with the smaller weight
+func (r Range) Intersect(other Range) Range {
+	result := NewRange()
+	for combo, weight := range r {
+		if otherWeight := other[combo]; otherWeight > 0 {
+			if otherWeight < weight {
+				weight = otherWeight
+			}
+			result[combo] = weight
+		}
+	}
+	return result
+}
+
+// Subtract returns the combos of r with the weight of other taken away
+func (r Range) Subtract(other Range) Range {
+	result := NewRange()
+	for combo, weight := range r {
+		if remaining := weight - other[combo]; remaining > 0 {
+			result[combo] = remaining
+		}
+	}
+	return result
+}
+
+// Scale returns the range with every weight multiplied by factor, capped at 1
+func (r Range) Scale(factor float64) Range {
+	result := NewRange()
+	for combo, weight := range r {
+		weight *= factor
+		if weight > 1 {
+			weight = 1
+		}
+		if weight > 0 {
+			result[combo] = weight
+		}
+	}
+	return result
+}
+
+// Without returns the range with combos sharing a card with dead removed
+func (r Range) Without(dead []Card) Range {
+	used := make(map[Card]bool, len(dead))
+	for _, card := range dead {
+		used[card] = true
+	}
+	result := NewRange()
+	for combo, weight := range r {
+		if !used[combo[0]] && !used[combo[1]] {
+			result[combo] = weight
+		}
+	}
+	return result
+}
+
+// ParseRange parses range notation into a Range. Tokens are comma-separated:
+// "QQ", "22+", "77-99", "AKs", "ATo+", "A2s-A5s", "KQ", explicit combos like
+// "SAHK", "15%" for the top 15% of hands, and "random" (or "any") for every
+// hand. A token may carry a weight, e.g. "AKo:0.5".
+func ParseRange(s string) (Range, error) {
+	r := NewRange()
+
+	for _, token := range strings.Split(s, ",") {
+		token = strings.TrimSpace(token)
+		if token == "" {
+			continue
+		}
+
+		weight := 1.0
+		if idx := strings.LastIndex(token, ":"); idx >= 0 {
+			w, err := strconv.ParseFloat(token[idx+1:], 64)
+			if err != nil || w <= 0 || w > 1 {
+				return nil, fmt.Errorf("invalid weight in range %q: must be in (0, 1]", token)
+			}
+			weight = w
+			hand := strings.TrimSpace(token[:idx])
+			if hand == "" {
+				return nil, fmt.Errorf("invalid range %q: weight without a hand", token)
+			}
+			token = hand
+		}
+
+		tokenCombos, err := parseRangeToken(token)
+		if err != nil {
+			return nil, err
+		}
+		for _, combo := range tokenCombos {
+			r.Add(combo, weight)
+		}
+	}
+
+	if len(r) == 0 {
+		return nil, fmt.Errorf("empty range: %q", s)
+	}
+	return r, nil
+}
+
+// parseRangeToken expands a single range token without its weight
+func parseRangeToken(token string) ([]Combo, error) {
+	if token == "" {
+		return nil, fmt.Errorf("invalid range: empty hand")
+	}
+	upper := strings.ToUpper(token)
+	if upper == "RANDOM" || upper == "ANY" {
+		return allCombos(), nil
+	}
+
+	// Percentile like "15%"
+	if strings.HasSuffix(upper, "%") {
+		percent, err := strconv.ParseFloat(strings.TrimSuffix(upper, "%"), 64)
+		if err != nil || percent <= 0 || percent > 100 {
+			return nil, fmt.Errorf("invalid range %q: percentile must be in (0, 100]", token)
+		}
+		return TopRange(percent).Combos(), nil
+	}
+
+	// Explicit combo like "SAHK", which starts with a suit
+	if strings.ContainsRune("HDCS", rune(upper[0])) {
+		combo, err := parseComboToken(upper)
+		if err != nil {
+			return nil, fmt.Errorf("invalid range %q: %v", token, err)
+		}
+		return []Combo{combo}, nil
+	}
+
+	// Span like "77-99" or "A2s-A5s"
+	if parts := strings.Split(upper, "-"); len(parts) == 2 {
+		hi1, lo1, kind1, err := parseHandClass(parts[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid range %q: %v", token, err)
+		}
+		hi2, lo2, kind2, err := parseHandClass(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid range %q: %v", token, err)
+		}
+		if kind1 != kind2 {
+			return nil, fmt.Errorf("invalid range %q: mismatched suitedness", token)
+		}
+
+		combos := []Combo{}
+		if hi1 == lo1 && hi2 == lo2 {
+			if hi1 > hi2 {
+				hi1, hi2 = hi2, hi1
+			}
+			for rank := hi1; rank <= hi2; rank++ {
+				combos = append(combos, classCombos(rank, rank, kind1)...)
+			}
+			return combos, nil
+		}
+		if hi1 != hi2 || hi1 == lo1 || hi2 == lo2 {
+			return nil, fmt.Errorf("invalid range %q: span must keep the same high card", token)
+		}
+		if lo1 > lo2 {
+			lo1, lo2 = lo2, lo1
+		}
+		for kicker := lo1; kicker <= lo2; kicker++ {
+			combos = append(combos, classCombos(hi1, kicker, kind1)...)
+		}
+		return combos, nil
+	}
+
+	// Open-ended like "22+" or "ATs+"
+	plus := strings.HasSuffix(upper, "+")
+	hi, lo, kind, err := parseHandClass(strings.TrimSuffix(upper, "+"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid range %q: %v", token, err)
+	}
+	if !plus {
+		return classCombos(hi, lo, kind), nil
+	}
+
+	combos := []Combo{}
+	if hi == lo {
+		for rank := hi; rank <= 14; rank++ {
+			combos = append(combos, classCombos(rank, rank, kind)...)
+		}
+		return combos, nil
+	}
+	for kicker := lo; kicker < hi; kicker++ {
+		combos = append(combos, classCombos(hi, kicker, kind)...)
+	}
+	return combos, nil
+}
+
+// parseComboToken parses two concatenated cards like "SAHK" or "D10C10"
+func parseComboToken(s string) (Combo, error) {
+	split := strings.IndexAny(s[1:], "HDCS") + 1
+	if split <= 0 {
+		return Combo{}, fmt.Errorf("invalid combo: %s", s)
+	}
+	card1, err := ParseCard(s[:split])
+	if err != nil {
+		return Combo{}, err
+	}
+	card2, err := ParseCard(s[split:])
+	if err != nil {
+		return Combo{}, err
+	}
+	if card1 == card2 {
+		return Combo{}, fmt.Errorf("duplicate card in combo: %s", s)
+	}
+	return Combo{card1, card2}, nil
+}
+
+// parseHandClass parses "AK", "AKs", "AKo" or "TT" into high rank, low rank and
+// suitedness ('S', 'O' or 0 for both)
+func parseHandClass(s string) (int, int, byte, error) {
+	if len(s) != 2 && len(s) != 3 {
+		return 0, 0, 0, fmt.Errorf("invalid hand class: %s", s)
+	}
+
+	hi, err := parseRankChar(s[0])
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	lo, err := parseRankChar(s[1])
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	if lo > hi {
+		hi, lo = lo, hi
+	}
+
+	var kind byte
+	if len(s) == 3 {
+		kind = s[2]
+		if kind != 'S' && kind != 'O' {
+			return 0, 0, 0, fmt.Errorf("invalid suitedness: %c", kind)
+		}
+		if hi == lo {
+			return 0, 0, 0, fmt.Errorf("pairs cannot be suited or offsuit: %s", s)
+		}
+	}
+	return hi, lo, kind, nil
+}
+
+// parseRankChar converts a single rank character like 'A' or 'T' to its rank
+func parseRankChar(c byte) (int, error) {
+	idx := strings.IndexByte(rangeRanks, c)
+	if idx < 0 {
+		return 0, fmt.Errorf("invalid rank: %c", c)
+	}
+	return idx + 2, nil
+}
+
+// rankChar converts a rank to its single range notation character
+func rankChar(rank int) string {
+	return string(rangeRanks[rank-2])
+}
+
+// classCombos returns every combo of a hand class
+func classCombos(hi, lo int, kind byte) []Combo {
+	combos := []Combo{}
+
+	for i, suit1 := range rangeSuits {
+		for j, suit2 := range rangeSuits {
+			card1 := Card{Rank: hi, Suit: suit1}
+			card2 := Card{Rank: lo, Suit: suit2}
+			switch {
+			case hi == lo:
+				if j <= i {
+					continue
+				}
+			case kind == 'S':
+				if i != j {
+					continue
+				}
+			case kind == 'O':
+				if i == j {
+					continue
+				}
+			}
+			combos = append(combos, Combo{card1, card2})
+		}
+	}
+	return combos
+}
+
+// allCombos returns all 1326 two-card starting hands
+func allCombos() []Combo {
+	deck := newDeck()
+	combos := make([]Combo, 0, totalCombos)
+	for i := 0; i < len(deck); i++ {
+		for j := i + 1; j < len(deck); j++ {
+			combos = append(combos, Combo{deck[i], deck[j]})
+		}
+	}
+	return combos
+}
+
+// suitIndex returns the position of a suit in rangeSuits
+func suitIndex(suit string) int {
+	for i, s := range rangeSuits {
+		if s == suit {
+			return i
+		}
+	}
+	return len(rangeSuits)
+}
+
+// cardLess orders cards by rank, then by suit
+func cardLess(a, b Card) bool {
+	if a.Rank != b.Rank {
+		return a.Rank < b.Rank
+	}
+	return suitIndex(a.Suit) > suitIndex(b.Suit)
+}
+
+// canonicalCombo puts the higher card of a combo first
+func canonicalCombo(c Combo) Combo {
+	if cardLess(c[0], c[1]) {
+		return Combo{c[1], c[0]}
+	}
+	return c
+}
+
+// comboLess orders canonical combos by their high card, then their low card
+func comboLess(a, b Combo) bool {
+	if a[0] != b[0] {
+		return cardLess(a[0], b[0])
+	}
+	return cardLess(a[1], b[1])
+}
+
+// comboKey returns an order-independent key for a combo
+func comboKey(c Combo) string {
+	c = canonicalCombo(c)
+	return CardToString(c[0]) + CardToString(c[1])
+}
+
+// comboClass returns the starting hand class of a combo, e.g. "AKs"
+func comboClass(c Combo) string {
+	c = canonicalCombo(c)
+	label := rankChar(c[0].Rank) + rankChar(c[1].Rank)
+	if c[0].Rank == c[1].Rank {
+		return label
+	}
+	if c[0].Suit == c[1].Suit {
+		return label + "s"
+	}
+	return label + "o"
+}
+
+// removeBlocked drops combos that share a card with the dead cards
+func removeBlocked(combos []Combo, dead []Card) []Combo {
+	used := make(map[Card]bool, len(dead))
+	for _, card := range dead {
+		used[card] = true
+	}
+	live := make([]Combo, 0, len(combos))
+	for _, combo := range combos {
+		if !used[combo[0]] && !used[combo[1]] {
+			live = append(live, combo)
+		}
+	}
+	return live
+}
+
+// TopRange returns the best percent of starting hands by preflop equity
+// against a random hand. Classes are added whole until the target is reached.
+func TopRange(percent float64) Range {
+	r := NewRange()
+	target := percent / 100 * totalCombos
+	for _, class := range preflopEquities {
+		if r.Size() >= target {
+			break
+		}
+		combos, _ := parseRangeToken(class.Class)
+		for _, combo := range combos {
+			r.Add(combo, 1)
+		}
+	}
+	return r
+}
+
+// String returns the range in compact notation, e.g. "TT+,AQs+,AKo"
+func (r Range) String() string {
+	tokens := []string{}
+
+	// Whole classes are grouped by weight; partial classes are listed combo by combo
+	full := make(map[float64]map[string]bool)
+	partial := []Combo{}
+	for _, cell := range rangeClasses() {
+		weight, whole := r.classWeight(cell.combos)
+		if weight == 0 {
+			continue
+		}
+		if !whole {
+			for _, combo := range cell.combos {
+				if r.Weight(combo) > 0 {
+					partial = append(partial, combo)
+				}
+			}
+			continue
+		}
+		if full[weight] == nil {
+			full[weight] = make(map[string]bool)
+		}
+		full[weight][cell.label] = true
+	}
+
+	weights := make([]float64, 0, len(full))
+	for weight := range full {
+		weights = append(weights, weight)
+	}
+	sort.Sort(sort.Reverse(sort.Float64Slice(weights)))
+
+	for _, weight := range weights {
+		for _, token := range compressClasses(full[weight]) {
+			tokens = append(tokens, withWeight(token, weight))
+		}
+	}
+	for _, combo := range partial {
+		combo = canonicalCombo(combo)
+		token := CardToString(combo[0]) + CardToString(combo[1])
+		tokens = append(tokens, withWeight(token, r.Weight(combo)))
+	}
+
+	return strings.Join(tokens, ",")
+}
+
+// classWeight returns the weight shared by all combos of a class, and whether
+// they all share it. A class with no combos in the range has weight 0.
+func (r Range) classWeight(combos []Combo) (float64, bool) {
+	first := r.Weight(combos[0])
+	whole := true
+	present := false
+	for _, combo := range combos {
+		weight := r.Weight(combo)
+		if weight > 0 {
+			present = true
+		}
+		if weight != first {
+			whole = false
+		}
+	}
+	if !present {
+		return 0, false
+	}
+	if !whole {
+		return -1, false
+	}
+	return first, true
+}
+
+// withWeight appends a weight suffix to a token unless the weight is 1
+func withWeight(token string, weight float64) string {
+	if weight == 1 {
+		return token
+	}
+	return token + ":" + strconv.FormatFloat(weight, 'g', 4, 64)
+}
+
+// compressClasses turns a set of class labels into the shortest tokens,
+// e.g. {"QQ", "KK", "AA"} into "QQ+"
+func compressClasses(labels map[string]bool) []string {
+	tokens := []string{}
+
+	// Pairs, from aces down
+	for hi := 14; hi >= 2; {
+		if !labels[rankChar(hi)+rankChar(hi)] {
+			hi--
+			continue
+		}
+		lo := hi
+		for lo > 2 && labels[rankChar(lo-1)+rankChar(lo-1)] {
+			lo--
+		}
+		tokens = append(tokens, rankSpan(hi, hi, lo, lo, ""))
+		hi = lo - 1
+	}
+
+	// Non-pairs, by high card; a kicker held both suited and offsuit is written without a suffix
+	for hi := 14; hi >= 3; hi-- {
+		kinds := make(map[int]string)
+		for lo := hi - 1; lo >= 2; lo-- {
+			label := rankChar(hi) + rankChar(lo)
+			switch {
+			case labels[label+"s"] && labels[label+"o"]:
+				kinds[lo] = ""
+			case labels[label+"s"]:
+				kinds[lo] = "s"
+			case labels[label+"o"]:
+				kinds[lo] = "o"
+			default:
+				kinds[lo] = "-"
+			}
+		}
+		for _, kind := range []string{"", "s", "o"} {
+			for top := hi - 1; top >= 2; {
+				if kinds[top] != kind {
+					top--
+					continue
+				}
+				bottom := top
+				for bottom > 2 && kinds[bottom-1] == kind {
+					bottom--
+				}
+				tokens = append(tokens, rankSpan(hi, top, hi, bottom, kind))
+				top = bottom - 1
+			}
+		}
+	}
+
+	return tokens
+}
+
+// rankSpan writes a run of classes from hi1/lo1 down to hi2/lo2, using "+"
+// when the run reaches the top
+func rankSpan(hi1, lo1, hi2, lo2 int, kind string) string {
+	first := rankChar(hi1) + rankChar(lo1) + kind
+	if hi1 == hi2 && lo1 == lo2 {
+		return first
+	}
+	last := rankChar(hi2) + rankChar(lo2) + kind
+	if (hi1 == lo1 && hi1 == 14) || (hi1 != lo1 && lo1 == hi1-1) {
+		return last + "+"
+	}
+	return first + "-" + last
+}
+
+// rangeClass is one of the 169 starting hand classes with its combos
+type rangeClass struct {
+	label  string
+	combos []Combo
+}
+
+// rangeClasses returns the starting hand classes in 13x13 grid order: rows
+// and columns run from aces down, suited hands above the diagonal and
+// offsuit hands below it
+func rangeClasses() []rangeClass {
+	classes := make([]rangeClass, 0, 169)
+	for row := 14; row >= 2; row-- {
+		for col := 14; col >= 2; col-- {
+			switch {
+			case row == col:
+				classes = append(classes, rangeClass{rankChar(row) + rankChar(col), classCombos(row, col, 0)})
+			case row > col:
+				classes = append(classes, rangeClass{rankChar(row) + rankChar(col) + "s", classCombos(row, col, 'S')})
+			default:
+				classes = append(classes, rangeClass{rankChar(col) + rankChar(row) + "o", classCombos(col, row, 'O')})
+			}
+		}
+	}
+	return classes
+}
+
+// GridCell is one starting hand class of the 13x13 range grid
+type GridCell struct {
+	Label       string  // e.g. "AKs"
+	Combos      float64 // Weighted combos of the class in the range
+	TotalCombos int     // 6 for pairs, 4 suited, 12 offsuit
+}
+
+// Grid returns the range as a 13x13 matrix of starting hand classes, aces first
+func (r Range) Grid() [13][13]GridCell {
+	var grid [13][13]GridCell
+	for i, class := range rangeClasses() {
+		cell := GridCell{Label: class.label, TotalCombos: len(class.combos)}
+		for _, combo := range class.combos {
+			cell.Combos += r.Weight(combo)
+		}
+		grid[i/13][i%13] = cell
+	}
+	return grid
+}
+
+// RangeEquity runs a Monte Carlo simulation of hero's hand against a villain
+// drawn from the range in proportion to combo weights. Combos that conflict
+// with known cards are removed; an error is returned if none are left.
+func RangeEquity(holeCards []Card, communityCards []Card, villainRange Range, numSimulations int) (win, tie, lose float64, err error) {
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	known := append(append([]Card{}, holeCards...), communityCards...)
+	combos := removeBlocked(villainRange.Combos(), known)
+	if len(combos) == 0 {
+		return 0, 0, 0, fmt.Errorf("villain range has no combos left after removing known cards")
+	}
+	cumulative := make([]float64, len(combos))
+	total := 0.0
+	for i, combo := range combos {
+		total += villainRange.Weight(combo)
+		cumulative[i] = total
+	}
+	cardsNeeded := 5 - len(communityCards)
+
+	wins, ties, losses := 0, 0, 0
+	for i := 0; i < numSimulations; i++ {
+		pick := sort.SearchFloat64s(cumulative, rng.Float64()*total)
+		if pick >= len(combos) {
+			pick = len(combos) - 1
+		}
+		villain := combos[pick]
+		deck := remainingDeck(append(known, villain[0], villain[1]))
+
+		// Deal remaining community cards with a partial shuffle
+		board := append([]Card{}, communityCards...)
+		for j := 0; j < cardsNeeded; j++ {
+			k := j + rng.Intn(len(deck)-j)
+			deck[j], deck[k] = deck[k], deck[j]
+			board = append(board, deck[j])
+		}
+
+		heroHand := EvaluateBestHand(append(append([]Card{}, holeCards...), board...))
+		villainHand := EvaluateBestHand(append([]Card{villain[0], villain[1]}, board...))
+
+		if heroHand.RankValue > villainHand.RankValue {
+			wins++
+		} else if heroHand.RankValue == villainHand.RankValue {
+			ties++
+		} else {
+			losses++
+		}
+	}
+
+	n := float64(numSimulations)
+	return float64(wins) / n, float64(ties) / n, float64(losses) / n, nil
+}
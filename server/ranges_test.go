@@ -0,0 +1,41 @@
+package main
+
+import "testing"
+
+func TestParseRangeRejectsWeightWithoutHand(t *testing.T) {
+	for _, s := range []string{"AK,:0.5", ":0.5", " : 1"} {
+		if _, err := ParseRange(s); err == nil {
+			t.Errorf("ParseRange(%q): expected an error", s)
+		}
+	}
+}
+
+func TestParseRangeWeights(t *testing.T) {
+	r, err := ParseRange("AA, KK:0.5")
+	if err != nil {
+		t.Fatalf("ParseRange: %v", err)
+	}
+	if len(r) != 12 {
+		t.Fatalf("got %d combos, want 12", len(r))
+	}
+	for combo, w := range r {
+		want := 1.0
+		if combo[0].Rank == 13 {
+			want = 0.5
+		}
+		if w != want {
+			t.Errorf("weight of %v = %v, want %v", combo, w, want)
+		}
+	}
+}
+
+func TestRangeEquityAllCombosBlocked(t *testing.T) {
+	villain, err := ParseRange("SAHA")
+	if err != nil {
+		t.Fatalf("ParseRange: %v", err)
+	}
+	hole := mustParseHand(t, "As Kd")
+	if _, _, _, err := RangeEquity(hole, nil, villain, 100); err == nil {
+		t.Fatal("expected an error when every villain combo is blocked")
+	}
+}
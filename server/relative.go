@@ -0,0 +1,218 @@
+package main
+
+import "sort"
+
+// RelativeHand classifies hero's hand against the community cards rather
+// than by category alone
+type RelativeHand struct {
+	Label         string // e.g. "Top Pair, Top Kicker", "Set", "Nut Flush"
+	HoleCardsUsed int    // Hole cards among the best cards
+}
+
+// ClassifyHand labels what hero holds at any street: the starting hand
+// preflop, otherwise how the hole cards combine with the board
+func ClassifyHand(holeCards []Card, communityCards []Card, bestHand EvaluatedHand) RelativeHand {
+	relative := RelativeHand{HoleCardsUsed: len(cardsIn(bestHand.Cards, holeCards))}
+	if len(communityCards) == 0 {
+		relative.Label = PreflopLabel(holeCards)
+		if relative.Label == "" {
+			relative.Label = GetHandName(bestHand.Rank)
+		}
+		return relative
+	}
+	if relative.HoleCardsUsed == 0 && len(bestHand.Cards) == 5 {
+		relative.Label = "Playing the Board"
+		return relative
+	}
+
+	relative.Label = GetHandName(bestHand.Rank)
+	if len(holeCards) != 2 {
+		return relative
+	}
+
+	boardRanks := descendingRanks(communityCards)
+	ranks := groupedRanks(bestHand.Cards)
+	pocketPair := holeCards[0].Rank == holeCards[1].Rank
+	heroHas := func(rank int) int {
+		return len(cardsOfRank(holeCards, rank))
+	}
+
+	switch bestHand.Rank {
+	case OnePair:
+		switch {
+		case pocketPair && heroHas(ranks[0]) == 2:
+			relative.Label = pocketPairLabel(ranks[0], boardRanks)
+		case heroHas(ranks[0]) == 0:
+			relative.Label = "Board Pair"
+		default:
+			kicker := holeCards[0].Rank
+			if kicker == ranks[0] {
+				kicker = holeCards[1].Rank
+			}
+			relative.Label = pairPosition(ranks[0], boardRanks) + " Pair, " + kickerLabel(kicker, boardRanks)
+		}
+	case TwoPair:
+		relative.Label = twoPairLabel(holeCards, ranks[0], ranks[1], boardRanks)
+	case ThreeOfAKind:
+		switch heroHas(ranks[0]) {
+		case 2:
+			relative.Label = "Set"
+		case 1:
+			relative.Label = "Trips"
+		default:
+			relative.Label = "Board Trips"
+		}
+	case Flush:
+		relative.Label = flushLabel(holeCards, communityCards, bestHand.Cards[0].Suit)
+	case Straight:
+		if _, high := checkStraight(bestHand.Cards); isNutStraight(high, boardRanks) {
+			relative.Label = "Nut Straight"
+		}
+	}
+	return relative
+}
+
+// pocketPairLabel places a pocket pair against the board ranks
+func pocketPairLabel(rank int, boardRanks []int) string {
+	switch {
+	case rank > boardRanks[0]:
+		return "Overpair"
+	case rank < boardRanks[len(boardRanks)-1]:
+		return "Underpair"
+	}
+	return "Middle Pocket Pair"
+}
+
+// pairPosition names which board rank hero paired
+func pairPosition(rank int, boardRanks []int) string {
+	switch rank {
+	case boardRanks[0]:
+		return "Top"
+	case boardRanks[len(boardRanks)-1]:
+		return "Bottom"
+	case boardRanks[1]:
+		return "Second"
+	}
+	return "Middle"
+}
+
+// kickerLabel grades a kicker among the ranks not on the board: the best
+// one is the top kicker, the next two are good
+func kickerLabel(kicker int, boardRanks []int) string {
+	better := 0
+	for rank := 14; rank > kicker; rank-- {
+		if !containsRank(boardRanks, rank) {
+			better++
+		}
+	}
+	switch {
+	case better == 0:
+		return "Top Kicker"
+	case better <= 2:
+		return "Good Kicker"
+	}
+	return "Weak Kicker"
+}
+
+// twoPairLabel tells which of the two pairs hero's hole cards make
+func twoPairLabel(holeCards []Card, high, low int, boardRanks []int) string {
+	hole := []int{holeCards[0].Rank, holeCards[1].Rank}
+	switch {
+	case hole[0] == hole[1] && (hole[0] == high || hole[0] == low):
+		return "Pocket Pair and Board Pair"
+	case containsRank(hole, high) && containsRank(hole, low):
+		switch {
+		case high == boardRanks[0] && low == boardRanks[1]:
+			return "Top Two Pair"
+		case high == boardRanks[0] && low == boardRanks[len(boardRanks)-1]:
+			return "Top and Bottom Pair"
+		case low == boardRanks[len(boardRanks)-1] && high == boardRanks[len(boardRanks)-2]:
+			return "Bottom Two Pair"
+		}
+		return "Two Pair"
+	case containsRank(hole, high) || containsRank(hole, low):
+		return "Pair and Board Pair"
+	}
+	return "Board Two Pair"
+}
+
+// flushLabel grades hero's highest flush card among the ranks of the suit
+// missing from the board
+func flushLabel(holeCards []Card, communityCards []Card, suit string) string {
+	high := 0
+	for _, card := range holeCards {
+		if card.Suit == suit && card.Rank > high {
+			high = card.Rank
+		}
+	}
+	onBoard := []int{}
+	for _, card := range communityCards {
+		if card.Suit == suit {
+			onBoard = append(onBoard, card.Rank)
+		}
+	}
+
+	better := 0
+	for rank := 14; rank > high; rank-- {
+		if !containsRank(onBoard, rank) {
+			better++
+		}
+	}
+	switch {
+	case better == 0:
+		return "Nut Flush"
+	case better == 1:
+		return "Second Nut Flush"
+	case high >= 10:
+		return "High Flush"
+	}
+	return "Low Flush"
+}
+
+// isNutStraight reports whether no higher straight can be made with two
+// hole cards on the board
+func isNutStraight(high int, boardRanks []int) bool {
+	for top := high + 1; top <= 14; top++ {
+		missing := 0
+		for rank := top - 4; rank <= top; rank++ {
+			if !containsRank(boardRanks, rank) {
+				missing++
+			}
+		}
+		if missing <= 2 {
+			return false
+		}
+	}
+	return true
+}
+
+// descendingRanks returns the distinct ranks of the cards, highest first
+func descendingRanks(cards []Card) []int {
+	ranks := groupedRanks(cards)
+	sort.Sort(sort.Reverse(sort.IntSlice(ranks)))
+	return ranks
+}
+
+// containsRank reports whether a rank is in the list
+func containsRank(ranks []int, rank int) bool {
+	for _, r := range ranks {
+		if r == rank {
+			return true
+		}
+	}
+	return false
+}
+
+// cardsIn returns the cards that also appear in another set
+func cardsIn(cards []Card, set []Card) []Card {
+	found := []Card{}
+	for _, card := range cards {
+		for _, other := range set {
+			if card == other {
+				found = append(found, card)
+				break
+			}
+		}
+	}
+	return found
+}
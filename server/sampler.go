@@ -0,0 +1,344 @@
+package main
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+)
+
+// Estimator selects the Monte Carlo estimator used by RunSimulation
+type Estimator int
+
+const (
+	EstimatorNaive Estimator = iota
+	EstimatorStratified
+	EstimatorAntithetic
+	EstimatorQuasiRandom
+)
+
+// quasiRandomBatches is the number of independently shifted Halton sequences
+// used to estimate the error of the quasi-random estimator
+const quasiRandomBatches = 8
+
+// haltonBases are the prime bases for each dealt card (up to 5 board + 2 hole)
+var haltonBases = []int{2, 3, 5, 7, 11, 13, 17}
+
+// SimulationResult holds the outcome of a simulation run
+type SimulationResult struct {
+	Win       float64
+	Tie       float64
+	Lose      float64
+	StdError  float64 // Standard error of the equity (win + tie/2) estimate
+	Trials    int
+	Estimator Estimator
+}
+
+// RunSimulation estimates hero's win/tie/lose probabilities against one random
+// opponent using the requested estimator. Dead cards, such as a discard, are
+// never dealt.
+func RunSimulation(holeCards []Card, communityCards []Card, dead []Card, numSimulations int, estimator Estimator) SimulationResult {
+	return runSimulation(rand.New(rand.NewSource(time.Now().UnixNano())), holeCards, communityCards, dead, numSimulations, estimator)
+}
+
+// runSimulation is RunSimulation drawing from the given source, so runs can
+// be repeated with a fixed seed
+func runSimulation(rng *rand.Rand, holeCards []Card, communityCards []Card, dead []Card, numSimulations int, estimator Estimator) SimulationResult {
+	switch estimator {
+	case EstimatorStratified:
+		return stratifiedSimulation(rng, holeCards, communityCards, dead, numSimulations)
+	case EstimatorAntithetic:
+		return antitheticSimulation(rng, holeCards, communityCards, dead, numSimulations)
+	case EstimatorQuasiRandom:
+		return quasiRandomSimulation(rng, holeCards, communityCards, dead, numSimulations)
+	}
+
+	win, tie, lose := monteCarloSimulation(rng, holeCards, communityCards, dead, numSimulations)
+
+	// Each trial scores 1, 0.5 or 0
+	mean := win + tie/2
+	variance := win + tie/4 - mean*mean
+	return SimulationResult{
+		Win:       win,
+		Tie:       tie,
+		Lose:      lose,
+		StdError:  math.Sqrt(math.Max(variance, 0) / float64(numSimulations)),
+		Trials:    numSimulations,
+		Estimator: EstimatorNaive,
+	}
+}
+
+// outcomeTally accumulates showdown results
+type outcomeTally struct {
+	wins, ties, losses int
+}
+
+// add records a showdown score (1, 0.5 or 0)
+func (t *outcomeTally) add(score float64) {
+	switch score {
+	case 1:
+		t.wins++
+	case 0.5:
+		t.ties++
+	default:
+		t.losses++
+	}
+}
+
+// result converts the tally into a SimulationResult
+func (t outcomeTally) result(stdError float64, estimator Estimator) SimulationResult {
+	total := float64(t.wins + t.ties + t.losses)
+	return SimulationResult{
+		Win:       float64(t.wins) / total,
+		Tie:       float64(t.ties) / total,
+		Lose:      float64(t.losses) / total,
+		StdError:  stdError,
+		Trials:    t.wins + t.ties + t.losses,
+		Estimator: estimator,
+	}
+}
+
+// dealScore plays out a single deal and scores it for hero. The first cards of
+// deal complete the board and the next two are the opponent's hole cards.
+func dealScore(holeCards []Card, communityCards []Card, deal []Card) float64 {
+	cardsNeeded := 5 - len(communityCards)
+	board := append(append([]Card{}, communityCards...), deal[:cardsNeeded]...)
+
+	heroHand := EvaluateBestHand(append(append([]Card{}, holeCards...), board...))
+	opponentHand := EvaluateBestHand(append([]Card{deal[cardsNeeded], deal[cardsNeeded+1]}, board...))
+
+	if heroHand.RankValue > opponentHand.RankValue {
+		return 1
+	} else if heroHand.RankValue == opponentHand.RankValue {
+		return 0.5
+	}
+	return 0
+}
+
+// liveDeck returns the unseen cards, less the dead ones, sorted from lowest
+// to highest rank
+func liveDeck(holeCards []Card, communityCards []Card, dead []Card) []Card {
+	deck := remainingDeck(append(append(append([]Card{}, holeCards...), communityCards...), dead...))
+	sort.SliceStable(deck, func(i, j int) bool {
+		return deck[i].Rank < deck[j].Rank
+	})
+	return deck
+}
+
+// dealPrefix shuffles the first n cards of deck into place, starting at from
+func dealPrefix(rng *rand.Rand, deck []Card, from, n int) {
+	for j := from; j < n; j++ {
+		k := j + rng.Intn(len(deck)-j)
+		deck[j], deck[k] = deck[k], deck[j]
+	}
+}
+
+// stratifiedSimulation stratifies deals by the next card to come, which is the
+// next board card (or the opponent's first card when the board is complete).
+// Every unseen card is an equally likely stratum, so trials are allocated
+// proportionally and only the variance within each stratum remains. With
+// fewer trials than strata, a random subset of strata gets one trial each.
+func stratifiedSimulation(rng *rand.Rand, holeCards []Card, communityCards []Card, dead []Card, numSimulations int) SimulationResult {
+	deck := liveDeck(holeCards, communityCards, dead)
+	dealSize := 5 - len(communityCards) + 2
+	strata := len(deck)
+
+	// Proportional allocation, with the remainder spread over random strata
+	perStratum := make([]int, strata)
+	for h := range perStratum {
+		perStratum[h] = numSimulations / strata
+	}
+	sampled := strata
+	if numSimulations < strata {
+		sampled = numSimulations
+	}
+	for _, h := range rng.Perm(strata)[:numSimulations%strata] {
+		perStratum[h]++
+	}
+
+	var result SimulationResult
+	withinSS := 0.0
+	withinDF := 0
+	totalSum, totalSumSq := 0.0, 0.0
+	for h, first := range deck {
+		if perStratum[h] == 0 {
+			continue
+		}
+
+		// Fix the stratum card in front and deal the rest at random
+		rest := make([]Card, 0, len(deck))
+		rest = append(rest, first)
+		rest = append(rest, deck[:h]...)
+		rest = append(rest, deck[h+1:]...)
+
+		var tally outcomeTally
+		sum, sumSq := 0.0, 0.0
+		for i := 0; i < perStratum[h]; i++ {
+			dealPrefix(rng, rest, 1, dealSize)
+			score := dealScore(holeCards, communityCards, rest[:dealSize])
+			tally.add(score)
+			sum += score
+			sumSq += score * score
+		}
+
+		// Every sampled stratum carries the same weight
+		stratum := tally.result(0, EstimatorStratified)
+		result.Win += stratum.Win / float64(sampled)
+		result.Tie += stratum.Tie / float64(sampled)
+		result.Lose += stratum.Lose / float64(sampled)
+		result.Trials += stratum.Trials
+
+		mean := sum / float64(perStratum[h])
+		withinSS += sumSq - float64(perStratum[h])*mean*mean
+		withinDF += perStratum[h] - 1
+		totalSum += sum
+		totalSumSq += sumSq
+	}
+
+	result.Estimator = EstimatorStratified
+	if withinDF > 0 {
+		result.StdError = math.Sqrt(withinSS / float64(withinDF) / float64(result.Trials))
+	} else {
+		// One trial per stratum leaves no within-stratum spread to measure
+		result.StdError = sampleStdError(totalSum, totalSumSq, result.Trials)
+	}
+	return result
+}
+
+// antitheticSimulation pairs each random deal with an antithetic deal. The
+// partner mirrors ranks (the k-th lowest unseen card becomes the k-th highest)
+// and swaps hero's suit with another suit. Both maps are bijections on the
+// unseen cards, so each deal is uniformly distributed, but outcomes within a
+// pair are negatively correlated.
+func antitheticSimulation(rng *rand.Rand, holeCards []Card, communityCards []Card, dead []Card, numSimulations int) SimulationResult {
+	deck := liveDeck(holeCards, communityCards, dead)
+	dealSize := 5 - len(communityCards) + 2
+
+	// Pair hero's suit with the next suit, and the other two with each other
+	suits := []string{"H", "D", "C", "S"}
+	first := 0
+	for i, suit := range suits {
+		if suit == holeCards[0].Suit {
+			first = i
+		}
+	}
+	partner := make(map[string]string, 4)
+	for i := 0; i < 4; i += 2 {
+		a, b := suits[(first+i)%4], suits[(first+i+1)%4]
+		partner[a], partner[b] = b, a
+	}
+
+	live := make(map[Card]bool, len(deck))
+	for _, card := range deck {
+		live[card] = true
+	}
+	mirror := make(map[Card]Card, len(deck))
+	for i, card := range deck {
+		mirrored := deck[len(deck)-1-i]
+		// Keep the suit when its partner card is already dealt
+		if swapped := (Card{Rank: mirrored.Rank, Suit: partner[mirrored.Suit]}); live[swapped] {
+			mirrored = swapped
+		}
+		mirror[card] = mirrored
+	}
+
+	// An odd trial count ends with one unpaired deal
+	pairs := numSimulations / 2
+	var tally outcomeTally
+	sum, sumSq := 0.0, 0.0
+	mirrored := make([]Card, dealSize)
+	for i := 0; i < pairs; i++ {
+		dealPrefix(rng, deck, 0, dealSize)
+		for j := 0; j < dealSize; j++ {
+			mirrored[j] = mirror[deck[j]]
+		}
+
+		score1 := dealScore(holeCards, communityCards, deck[:dealSize])
+		score2 := dealScore(holeCards, communityCards, mirrored)
+		tally.add(score1)
+		tally.add(score2)
+
+		pairMean := (score1 + score2) / 2
+		sum += pairMean
+		sumSq += pairMean * pairMean
+	}
+	if numSimulations%2 == 1 {
+		dealPrefix(rng, deck, 0, dealSize)
+		tally.add(dealScore(holeCards, communityCards, deck[:dealSize]))
+	}
+
+	return tally.result(sampleStdError(sum, sumSq, pairs), EstimatorAntithetic)
+}
+
+// quasiRandomSimulation deals cards from a Halton low-discrepancy sequence
+// instead of independent random numbers. Each batch applies its own random
+// shift so the estimate stays unbiased and the batches give an error estimate.
+func quasiRandomSimulation(rng *rand.Rand, holeCards []Card, communityCards []Card, dead []Card, numSimulations int) SimulationResult {
+	deck := liveDeck(holeCards, communityCards, dead)
+	dealSize := 5 - len(communityCards) + 2
+
+	var tally outcomeTally
+	sum, sumSq := 0.0, 0.0
+	shift := make([]float64, dealSize)
+	point := make([]Card, len(deck))
+	batches := 0
+	for batch := 0; batch < quasiRandomBatches; batch++ {
+		// Trials are split as evenly as the count allows
+		perBatch := numSimulations / quasiRandomBatches
+		if batch < numSimulations%quasiRandomBatches {
+			perBatch++
+		}
+		if perBatch == 0 {
+			continue
+		}
+		batches++
+
+		for j := range shift {
+			shift[j] = rng.Float64()
+		}
+
+		batchSum := 0.0
+		for i := 1; i <= perBatch; i++ {
+			// Each coordinate picks one card from those not yet dealt. Every
+			// point starts from the unpermuted deck so it depends on its own
+			// coordinates only.
+			copy(point, deck)
+			for j := 0; j < dealSize; j++ {
+				u := math.Mod(radicalInverse(i, haltonBases[j])+shift[j], 1)
+				k := j + int(u*float64(len(point)-j))
+				point[j], point[k] = point[k], point[j]
+			}
+			score := dealScore(holeCards, communityCards, point[:dealSize])
+			tally.add(score)
+			batchSum += score
+		}
+
+		batchMean := batchSum / float64(perBatch)
+		sum += batchMean
+		sumSq += batchMean * batchMean
+	}
+
+	return tally.result(sampleStdError(sum, sumSq, batches), EstimatorQuasiRandom)
+}
+
+// radicalInverse returns the i-th element of the van der Corput sequence in base b
+func radicalInverse(i, base int) float64 {
+	result := 0.0
+	f := 1.0 / float64(base)
+	for i > 0 {
+		result += f * float64(i%base)
+		i /= base
+		f /= float64(base)
+	}
+	return result
+}
+
+// sampleStdError returns the standard error of the mean of n samples
+func sampleStdError(sum, sumSq float64, n int) float64 {
+	if n < 2 {
+		return 0
+	}
+	mean := sum / float64(n)
+	variance := (sumSq - float64(n)*mean*mean) / float64(n-1)
+	return math.Sqrt(math.Max(variance, 0) / float64(n))
+}
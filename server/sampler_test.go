@@ -0,0 +1,111 @@
+package main
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// equitySpread runs an estimator repeatedly from one fixed seed and returns
+// the mean and sample variance of its equity estimates
+func equitySpread(estimator Estimator, holeCards []Card, communityCards []Card, runs, trials int) (mean, variance float64) {
+	rng := rand.New(rand.NewSource(42))
+	sum, sumSq := 0.0, 0.0
+	for i := 0; i < runs; i++ {
+		result := runSimulation(rng, holeCards, communityCards, nil, trials, estimator)
+		equity := result.Win + result.Tie/2
+		sum += equity
+		sumSq += equity * equity
+	}
+	mean = sum / float64(runs)
+	return mean, (sumSq - float64(runs)*mean*mean) / float64(runs-1)
+}
+
+func TestEstimatorVarianceAgainstNaive(t *testing.T) {
+	if testing.Short() {
+		t.Skip("repeated simulations are slow")
+	}
+
+	const runs, trials = 100, 92
+	spots := []struct {
+		name           string
+		holeCards      []Card
+		communityCards []Card
+		stratifiedGain bool // The stratum card decides much of the outcome
+	}{
+		{"flush draw on the flop", []Card{{14, "S"}, {13, "S"}}, []Card{{12, "S"}, {7, "S"}, {2, "D"}}, false},
+		// On the turn the stratum is the river card, which decides the draw
+		{"flush draw on the turn", []Card{{14, "S"}, {13, "S"}}, []Card{{12, "S"}, {7, "S"}, {2, "D"}, {3, "H"}}, true},
+	}
+	for _, spot := range spots {
+		naiveMean, naiveVariance := equitySpread(EstimatorNaive, spot.holeCards, spot.communityCards, runs, trials)
+		for _, estimator := range []Estimator{EstimatorStratified, EstimatorAntithetic, EstimatorQuasiRandom} {
+			mean, variance := equitySpread(estimator, spot.holeCards, spot.communityCards, runs, trials)
+			t.Logf("%s: estimator %d variance %.6f, naive %.6f", spot.name, estimator, variance, naiveVariance)
+
+			// Every estimator is unbiased, so the means agree within the
+			// error of both
+			if tolerance := 4 * math.Sqrt((variance+naiveVariance)/runs); math.Abs(mean-naiveMean) > tolerance {
+				t.Errorf("%s: estimator %d mean %.4f, naive %.4f", spot.name, estimator, mean, naiveMean)
+			}
+			if variance > naiveVariance*1.25 {
+				t.Errorf("%s: estimator %d variance %.6f exceeds naive %.6f", spot.name, estimator, variance, naiveVariance)
+			}
+			if estimator == EstimatorStratified && spot.stratifiedGain && variance >= naiveVariance*0.9 {
+				t.Errorf("%s: stratifying left variance %.6f against naive %.6f", spot.name, variance, naiveVariance)
+			}
+		}
+	}
+}
+
+func TestEstimatorsRunRequestedTrials(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	hole := []Card{{14, "S"}, {13, "S"}}
+	for _, trials := range []int{1, 7, 45, 101} {
+		for _, estimator := range []Estimator{EstimatorNaive, EstimatorStratified, EstimatorAntithetic, EstimatorQuasiRandom} {
+			if result := runSimulation(rng, hole, nil, nil, trials, estimator); result.Trials != trials {
+				t.Errorf("estimator %d ran %d trials, want %d", estimator, result.Trials, trials)
+			}
+		}
+	}
+}
+
+func TestSimulationRepeatsWithFixedSeed(t *testing.T) {
+	hole := []Card{{12, "H"}, {12, "D"}}
+	board := []Card{{9, "C"}, {5, "S"}, {2, "H"}}
+	for _, estimator := range []Estimator{EstimatorNaive, EstimatorStratified, EstimatorAntithetic, EstimatorQuasiRandom} {
+		first := runSimulation(rand.New(rand.NewSource(7)), hole, board, nil, 200, estimator)
+		second := runSimulation(rand.New(rand.NewSource(7)), hole, board, nil, 200, estimator)
+		if first != second {
+			t.Errorf("estimator %d gave %+v then %+v from the same seed", estimator, first, second)
+		}
+	}
+}
+
+func TestSimulationSkipsDeadCards(t *testing.T) {
+	// With all but three cards dead the river and the opponent's hole cards
+	// come from 4s 5s 6s: hero ties when the 6 falls and loses otherwise
+	hole := mustParseHand(t, "2c 3d")
+	board := mustParseHand(t, "Ah Kh Qh Jh")
+	live := map[Card]bool{}
+	for _, card := range mustParseHand(t, "4s 5s 6s") {
+		live[card] = true
+	}
+	dead := []Card{}
+	for _, card := range remainingDeck(append(append([]Card{}, hole...), board...)) {
+		if !live[card] {
+			dead = append(dead, card)
+		}
+	}
+
+	if deck := liveDeck(hole, board, dead); len(deck) != len(live) {
+		t.Fatalf("live deck has %d cards, want %d", len(deck), len(live))
+	}
+	rng := rand.New(rand.NewSource(3))
+	for _, estimator := range []Estimator{EstimatorNaive, EstimatorStratified, EstimatorAntithetic, EstimatorQuasiRandom} {
+		result := runSimulation(rng, hole, board, dead, 600, estimator)
+		if result.Win != 0 || math.Abs(result.Tie-1.0/3) > 0.08 {
+			t.Errorf("estimator %d: win %.3f tie %.3f, want 0 and 1/3", estimator, result.Win, result.Tie)
+		}
+	}
+}
@@ -5,122 +5,631 @@ import (
 	"fmt"
 
 	pb "github.com/mispice/Poker-dist-assignment/proto"
+	pbv1 "github.com/mispice/Poker-dist-assignment/proto/v1"
 )
 
-// PokerServer implements the PokerService gRPC service
+// PokerServer implements the PokerService gRPC service. Hand evaluation,
+// comparison and probability are served by the poker.v1 implementation,
+// converting cards between strings and structured messages.
 type PokerServer struct {
 	pb.UnimplementedPokerServiceServer
+	v1 *PokerServerV1
 }
 
 // NewPokerServer creates a new PokerServer instance
 func NewPokerServer() *PokerServer {
-	return &PokerServer{}
+	return &PokerServer{v1: NewPokerServerV1()}
 }
 
 // EvaluateHand evaluates the best poker hand from hole cards and community cards
 func (s *PokerServer) EvaluateHand(ctx context.Context, req *pb.HandRequest) (*pb.HandResponse, error) {
-	// Parse hole cards
-	holeCards := make([]Card, 0, len(req.HoleCards))
-	for _, cardStr := range req.HoleCards {
-		card, err := ParseCard(cardStr)
-		if err != nil {
-			return nil, fmt.Errorf("invalid hole card %s: %v", cardStr, err)
-		}
-		holeCards = append(holeCards, card)
+	v1Req, err := handRequestToV1(req)
+	if err != nil {
+		return nil, err
 	}
+	resp, err := s.v1.EvaluateHand(ctx, v1Req)
+	if err != nil {
+		return nil, err
+	}
+	return handResponseFromV1(resp), nil
+}
 
-	// Parse community cards
-	communityCards := make([]Card, 0, len(req.CommunityCards))
-	for _, cardStr := range req.CommunityCards {
-		card, err := ParseCard(cardStr)
-		if err != nil {
-			return nil, fmt.Errorf("invalid community card %s: %v", cardStr, err)
+// CompareHands compares two poker hands and determines the winner
+func (s *PokerServer) CompareHands(ctx context.Context, req *pb.CompareRequest) (*pb.CompareResponse, error) {
+	hand1, err := handRequestToV1(req.Hand1)
+	if err != nil {
+		return nil, fmt.Errorf("error evaluating hand 1: %v", err)
+	}
+	hand2, err := handRequestToV1(req.Hand2)
+	if err != nil {
+		return nil, fmt.Errorf("error evaluating hand 2: %v", err)
+	}
+
+	resp, err := s.v1.CompareHands(ctx, &pbv1.CompareRequest{Hand1: hand1, Hand2: hand2})
+	if err != nil {
+		return nil, err
+	}
+
+	compare := &pb.CompareResponse{
+		Winner:      resp.Winner,
+		Hand1Result: handResponseFromV1(resp.Hand1Result),
+		Hand2Result: handResponseFromV1(resp.Hand2Result),
+	}
+	if tieBreak := resp.TieBreak; tieBreak != nil {
+		compare.TieBreak = &pb.TieBreak{
+			Level:           levelNames[tieBreak.Level],
+			Kicker:          tieBreak.Kicker,
+			Hand1Cards:      protoToCardStrings(tieBreak.Hand1Cards),
+			Hand2Cards:      protoToCardStrings(tieBreak.Hand2Cards),
+			BoardPlays:      tieBreak.Hand1BoardPlays && tieBreak.Hand2BoardPlays,
+			Hand1BoardPlays: tieBreak.Hand1BoardPlays,
+			Hand2BoardPlays: tieBreak.Hand2BoardPlays,
+			Explanation:     tieBreak.Explanation,
 		}
-		communityCards = append(communityCards, card)
 	}
+	return compare, nil
+}
 
-	// Combine all cards
-	allCards := append(holeCards, communityCards...)
+// CalculateProbability runs Monte Carlo simulation to calculate win probability
+func (s *PokerServer) CalculateProbability(ctx context.Context, req *pb.SimRequest) (*pb.SimResponse, error) {
+	holeCards, err := cardStringsToProto(req.HoleCards, "hole")
+	if err != nil {
+		return nil, err
+	}
+	communityCards, err := cardStringsToProto(req.CommunityCards, "community")
+	if err != nil {
+		return nil, err
+	}
+
+	variant, err := variantToProto(req.Variant)
+	if err != nil {
+		return nil, err
+	}
 
-	if len(allCards) < 5 {
-		return nil, fmt.Errorf("need at least 5 cards, got %d", len(allCards))
+	resp, err := s.v1.CalculateProbability(ctx, &pbv1.SimRequest{
+		Variant:        variant,
+		HoleCards:      holeCards,
+		CommunityCards: communityCards,
+		NumSimulations: req.NumSimulations,
+		Estimator:      pbv1.Estimator(req.Estimator),
+		Wild:           wildToV1(req.Wild),
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// Evaluate the best hand
-	bestHand := EvaluateBestHand(allCards)
+	return &pb.SimResponse{
+		WinProbability:  resp.WinProbability,
+		TieProbability:  resp.TieProbability,
+		LoseProbability: resp.LoseProbability,
+		SimulationsRun:  resp.SimulationsRun,
+		EstimatorUsed:   pb.Estimator(resp.EstimatorUsed),
+		StandardError:   resp.StandardError,
+	}, nil
+}
+
+// handRequestToV1 converts a hand request with card strings to poker.v1
+func handRequestToV1(req *pb.HandRequest) (*pbv1.HandRequest, error) {
+	holeCards, err := cardStringsToProto(req.GetHoleCards(), "hole")
+	if err != nil {
+		return nil, err
+	}
+	communityCards, err := cardStringsToProto(req.GetCommunityCards(), "community")
+	if err != nil {
+		return nil, err
+	}
+	variant, err := variantToProto(req.GetVariant())
+	if err != nil {
+		return nil, err
+	}
+	return &pbv1.HandRequest{
+		Variant:        variant,
+		HoleCards:      holeCards,
+		CommunityCards: communityCards,
+		Wild:           wildToV1(req.GetWild()),
+	}, nil
+}
 
-	// Convert best cards to strings
-	bestCardStrings := make([]string, len(bestHand.Cards))
-	for i, card := range bestHand.Cards {
-		bestCardStrings[i] = CardToString(card)
+// wildToV1 converts wild card rules to poker.v1
+func wildToV1(wild *pb.WildCards) *pbv1.WildCards {
+	if wild == nil {
+		return nil
 	}
+	return &pbv1.WildCards{Jokers: wild.Jokers, Bug: wild.Bug, WildRank: pbv1.Rank(wild.WildRank)}
+}
 
+// handResponseFromV1 converts a poker.v1 hand response to card strings
+func handResponseFromV1(resp *pbv1.HandResponse) *pb.HandResponse {
+	draws := make([]*pb.Draw, len(resp.Draws))
+	for i, draw := range resp.Draws {
+		draws[i] = &pb.Draw{Name: draw.Name, Outs: protoToCardStrings(draw.Outs)}
+	}
+	substitutions := make([]*pb.Substitution, len(resp.Substitutions))
+	for i, sub := range resp.Substitutions {
+		wild := protoToCardStrings([]*pbv1.Card{sub.WildCard, sub.PlaysAs})
+		substitutions[i] = &pb.Substitution{WildCard: wild[0], PlaysAs: wild[1]}
+	}
 	return &pb.HandResponse{
-		BestHandName:  GetHandName(bestHand.Rank),
-		HandRankValue: bestHand.RankValue,
-		BestCards:     bestCardStrings,
+		BestHandName:         resp.CategoryName,
+		HandRankValue:        resp.RankValue,
+		BestCards:            protoToCardStrings(resp.BestCards),
+		Draws:                draws,
+		EquivalenceClass:     resp.EquivalenceClass,
+		FiveCardProbability:  resp.FiveCardProbability,
+		SevenCardProbability: resp.SevenCardProbability,
+		Percentile:           resp.Percentile,
+		HandLabel:            resp.HandLabel,
+		HoleCardsUsed:        resp.HoleCardsUsed,
+		Substitutions:        substitutions,
+	}
+}
+
+// CalculateHandStrength computes hand strength, hand potential and EHS for hero
+func (s *PokerServer) CalculateHandStrength(ctx context.Context, req *pb.HandStrengthRequest) (*pb.HandStrengthResponse, error) {
+	holeCards, err := parseCards(req.HoleCards, "hole")
+	if err != nil {
+		return nil, err
+	}
+	communityCards, err := parseCards(req.CommunityCards, "community")
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := CalculateHandStrength(holeCards, communityCards, int(req.NumOpponents))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.HandStrengthResponse{
+		HandStrength:           result.HandStrength,
+		PositivePotential:      result.PositivePotential,
+		NegativePotential:      result.NegativePotential,
+		EffectiveHandStrength:  result.Effective,
+		OptimisticHandStrength: result.Optimistic,
+		CombosAhead:            int32(result.Ahead),
+		CombosTied:             int32(result.Tied),
+		CombosBehind:           int32(result.Behind),
 	}, nil
 }
 
-// CompareHands compares two poker hands and determines the winner
-func (s *PokerServer) CompareHands(ctx context.Context, req *pb.CompareRequest) (*pb.CompareResponse, error) {
-	// Evaluate hand 1
-	hand1Result, err := s.EvaluateHand(ctx, req.Hand1)
+// AnalyzeBoard classifies the texture of the community cards
+func (s *PokerServer) AnalyzeBoard(ctx context.Context, req *pb.BoardRequest) (*pb.BoardTextureResponse, error) {
+	communityCards, err := parseCards(req.CommunityCards, "community")
 	if err != nil {
-		return nil, fmt.Errorf("error evaluating hand 1: %v", err)
+		return nil, err
 	}
 
-	// Evaluate hand 2
-	hand2Result, err := s.EvaluateHand(ctx, req.Hand2)
+	texture, err := AnalyzeBoard(communityCards)
 	if err != nil {
-		return nil, fmt.Errorf("error evaluating hand 2: %v", err)
+		return nil, err
 	}
 
-	// Determine winner
-	var winner int32
-	if hand1Result.HandRankValue > hand2Result.HandRankValue {
-		winner = 1
-	} else if hand2Result.HandRankValue > hand1Result.HandRankValue {
-		winner = 2
-	} else {
-		winner = 0 // Tie
+	return &pb.BoardTextureResponse{
+		Pairing:          texture.Pairing,
+		Paired:           texture.Paired,
+		Trips:            texture.Trips,
+		SuitPattern:      texture.SuitPattern,
+		MaxSuitCount:     int32(texture.MaxSuitCount),
+		FlushPossible:    texture.FlushPossible,
+		Connectivity:     texture.Connectivity,
+		StraightWindows:  int32(texture.StraightWindows),
+		StraightPossible: texture.StraightPossible,
+		HighCard:         RankToString(texture.HighCard),
+		Wetness:          int32(texture.Wetness),
+		PossibleHands:    handCountsToProto(texture.PossibleHands),
+		PossibleDraws:    handCountsToProto(texture.PossibleDraws),
+	}, nil
+}
+
+// handCountsToProto converts hand counts to their proto form
+func handCountsToProto(counts []HandCount) []*pb.HandCount {
+	result := make([]*pb.HandCount, len(counts))
+	for i, count := range counts {
+		result[i] = &pb.HandCount{Name: count.Name, Combos: int32(count.Combos)}
+	}
+	return result
+}
+
+// AnalyzeNuts lists the best possible holdings on a board and, given hero's
+// hole cards, the opponent holdings that beat, tie or lose to hero
+func (s *PokerServer) AnalyzeNuts(ctx context.Context, req *pb.NutsRequest) (*pb.NutsResponse, error) {
+	communityCards, err := parseCards(req.CommunityCards, "community")
+	if err != nil {
+		return nil, err
+	}
+	holeCards, err := parseCards(req.HoleCards, "hole")
+	if err != nil {
+		return nil, err
 	}
 
-	return &pb.CompareResponse{
-		Winner:       winner,
-		Hand1Result:  hand1Result,
-		Hand2Result:  hand2Result,
+	analysis, err := AnalyzeNuts(communityCards, holeCards)
+	if err != nil {
+		return nil, err
+	}
+
+	maxClasses := int(req.MaxClasses)
+	if maxClasses <= 0 {
+		maxClasses = defaultNutClasses
+	}
+
+	nutClasses := []*pb.NutClass{}
+	for i, class := range analysis.Classes {
+		if i >= maxClasses {
+			break
+		}
+		nutClasses = append(nutClasses, &pb.NutClass{
+			Rank:          int32(i + 1),
+			HandName:      GetHandName(class.Hand.Rank),
+			Description:   DescribeHand(class.Hand),
+			HandRankValue: class.Hand.RankValue,
+			Combos:        int32(len(class.Combos)),
+			Holdings:      holdingsToProto(class.Combos),
+		})
+	}
+
+	return &pb.NutsResponse{
+		NutClasses:    nutClasses,
+		TotalClasses:  int32(len(analysis.Classes)),
+		HeroClassRank: int32(analysis.HeroClass),
+		BeatsHero:     holdingsToProto(analysis.Beats),
+		TiesHero:      holdingsToProto(analysis.Ties),
+		LosesToHero:   holdingsToProto(analysis.Loses),
 	}, nil
 }
 
-// CalculateProbability runs Monte Carlo simulation to calculate win probability
-func (s *PokerServer) CalculateProbability(ctx context.Context, req *pb.SimRequest) (*pb.SimResponse, error) {
-	// Parse hole cards
-	holeCards := make([]Card, 0, len(req.HoleCards))
-	for _, cardStr := range req.HoleCards {
-		card, err := ParseCard(cardStr)
+// holdingsToProto converts combos to their proto form
+func holdingsToProto(combos []Combo) []*pb.Holding {
+	result := make([]*pb.Holding, len(combos))
+	for i, combo := range combos {
+		result[i] = &pb.Holding{Cards: []string{CardToString(combo[0]), CardToString(combo[1])}}
+	}
+	return result
+}
+
+// AnalyzeBlockers reports how hero's hole cards shrink each hand category of an opponent range
+func (s *PokerServer) AnalyzeBlockers(ctx context.Context, req *pb.BlockerRequest) (*pb.BlockerResponse, error) {
+	holeCards, err := parseCards(req.HoleCards, "hole")
+	if err != nil {
+		return nil, err
+	}
+	communityCards, err := parseCards(req.CommunityCards, "community")
+	if err != nil {
+		return nil, err
+	}
+	opponentRange, err := ParseRange(req.OpponentRange)
+	if err != nil {
+		return nil, err
+	}
+
+	analysis, err := AnalyzeBlockers(holeCards, communityCards, opponentRange)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make([]*pb.BlockerGroup, len(analysis.Groups))
+	for i, group := range analysis.Groups {
+		groups[i] = &pb.BlockerGroup{
+			HandName:        GetHandName(group.Rank),
+			CombosBefore:    group.CombosBefore,
+			CombosAfter:     group.CombosAfter,
+			BlockedFraction: 1 - group.CombosAfter/group.CombosBefore,
+			NutDescription:  DescribeHand(group.NutHand),
+			NutCombosBefore: group.NutCombosBefore,
+			NutCombosAfter:  group.NutCombosAfter,
+		}
+	}
+
+	return &pb.BlockerResponse{
+		Groups:            groups,
+		TotalCombosBefore: analysis.TotalBefore,
+		TotalCombosAfter:  analysis.TotalAfter,
+	}, nil
+}
+
+// DescribeRange parses a range and returns it in compact notation with its 13x13 grid
+func (s *PokerServer) DescribeRange(ctx context.Context, req *pb.RangeRequest) (*pb.RangeResponse, error) {
+	r, err := ParseRange(req.Range)
+	if err != nil {
+		return nil, err
+	}
+	deadCards, err := parseCards(req.DeadCards, "dead")
+	if err != nil {
+		return nil, err
+	}
+	return rangeToProto(r.Without(deadCards)), nil
+}
+
+// CombineRanges applies a range operation to two ranges
+func (s *PokerServer) CombineRanges(ctx context.Context, req *pb.RangeOperationRequest) (*pb.RangeResponse, error) {
+	left, err := ParseRange(req.Left)
+	if err != nil {
+		return nil, err
+	}
+	deadCards, err := parseCards(req.DeadCards, "dead")
+	if err != nil {
+		return nil, err
+	}
+
+	var result Range
+	if req.Operation == pb.RangeOperation_RANGE_WEIGHT {
+		if req.Weight <= 0 {
+			return nil, fmt.Errorf("weight must be positive, got %v", req.Weight)
+		}
+		result = left.Scale(req.Weight)
+	} else {
+		right, err := ParseRange(req.Right)
 		if err != nil {
-			return nil, fmt.Errorf("invalid hole card %s: %v", cardStr, err)
+			return nil, err
+		}
+		switch req.Operation {
+		case pb.RangeOperation_RANGE_UNION:
+			result = left.Union(right)
+		case pb.RangeOperation_RANGE_INTERSECT:
+			result = left.Intersect(right)
+		case pb.RangeOperation_RANGE_SUBTRACT:
+			result = left.Subtract(right)
+		default:
+			return nil, fmt.Errorf("unknown range operation: %v", req.Operation)
 		}
-		holeCards = append(holeCards, card)
 	}
 
-	// Parse community cards
-	communityCards := make([]Card, 0, len(req.CommunityCards))
-	for _, cardStr := range req.CommunityCards {
-		card, err := ParseCard(cardStr)
+	return rangeToProto(result.Without(deadCards)), nil
+}
+
+// rangeToProto converts a range to its notation, size and grid
+func rangeToProto(r Range) *pb.RangeResponse {
+	size := r.Size()
+	grid := r.Grid()
+	rows := make([]*pb.RangeGridRow, len(grid))
+	for i, row := range grid {
+		cells := make([]*pb.RangeGridCell, len(row))
+		for j, cell := range row {
+			cells[j] = &pb.RangeGridCell{
+				Label:       cell.Label,
+				Combos:      cell.Combos,
+				TotalCombos: int32(cell.TotalCombos),
+				Frequency:   cell.Combos / float64(cell.TotalCombos),
+			}
+		}
+		rows[i] = &pb.RangeGridRow{Cells: cells}
+	}
+
+	return &pb.RangeResponse{
+		Notation: r.String(),
+		Combos:   size,
+		Percent:  size / totalCombos * 100,
+		Grid:     rows,
+	}
+}
+
+// CalculateEquityHeatmap computes hero's equity for every starting hand class against a range
+func (s *PokerServer) CalculateEquityHeatmap(ctx context.Context, req *pb.HeatmapRequest) (*pb.HeatmapResponse, error) {
+	communityCards, err := parseCards(req.CommunityCards, "community")
+	if err != nil {
+		return nil, err
+	}
+	opponentRange := TopRange(100)
+	if req.OpponentRange != "" {
+		opponentRange, err = ParseRange(req.OpponentRange)
 		if err != nil {
-			return nil, fmt.Errorf("invalid community card %s: %v", cardStr, err)
+			return nil, err
 		}
-		communityCards = append(communityCards, card)
 	}
 
-	// Validate inputs
-	if len(holeCards) != 2 {
-		return nil, fmt.Errorf("need exactly 2 hole cards, got %d", len(holeCards))
+	grid, err := EquityHeatmap(communityCards, opponentRange, int(req.NumSimulations))
+	if err != nil {
+		return nil, err
 	}
-	if len(communityCards) > 5 {
-		return nil, fmt.Errorf("cannot have more than 5 community cards, got %d", len(communityCards))
+
+	rows := make([]*pb.HeatmapRow, len(grid))
+	for i, row := range grid {
+		cells := make([]*pb.HeatmapCell, len(row))
+		for j, cell := range row {
+			cells[j] = &pb.HeatmapCell{
+				Label:  cell.Label,
+				Combos: int32(cell.Combos),
+				Win:    cell.Win,
+				Tie:    cell.Tie,
+				Equity: cell.Equity,
+			}
+		}
+		rows[i] = &pb.HeatmapRow{Cells: cells}
+	}
+	return &pb.HeatmapResponse{Rows: rows}, nil
+}
+
+// CalculateEquityTimeline reports each player's equity street by street
+func (s *PokerServer) CalculateEquityTimeline(ctx context.Context, req *pb.TimelineRequest) (*pb.TimelineResponse, error) {
+	hands := make([][]Card, len(req.Players))
+	for i, player := range req.Players {
+		hand, err := parseCards(player.Cards, fmt.Sprintf("player %d", i+1))
+		if err != nil {
+			return nil, err
+		}
+		hands[i] = hand
+	}
+	communityCards, err := parseCards(req.CommunityCards, "community")
+	if err != nil {
+		return nil, err
+	}
+
+	timeline, err := EquityTimeline(hands, communityCards)
+	if err != nil {
+		return nil, err
+	}
+
+	streets := make([]*pb.StreetEquity, len(timeline))
+	for i, street := range timeline {
+		streets[i] = &pb.StreetEquity{
+			Street:      street.Street,
+			Board:       cardsToStrings(street.Board),
+			NewCards:    cardsToStrings(street.NewCards),
+			Equities:    street.Equities,
+			Leader:      int32(street.Leader),
+			LeadChanged: street.LeadChanged,
+		}
+	}
+	return &pb.TimelineResponse{Streets: streets}, nil
+}
+
+// AnalyzeFlops streams a hero range against a villain range over every distinct flop
+func (s *PokerServer) AnalyzeFlops(req *pb.FlopAnalysisRequest, stream pb.PokerService_AnalyzeFlopsServer) error {
+	heroRange, err := ParseRange(req.HeroRange)
+	if err != nil {
+		return fmt.Errorf("hero range: %v", err)
+	}
+	villainRange, err := ParseRange(req.VillainRange)
+	if err != nil {
+		return fmt.Errorf("villain range: %v", err)
+	}
+
+	flops := FlopsForRanges(heroRange, villainRange, FlopSubset(int(req.SubsetSize)))
+	done := 0
+	report := func(result FlopResult) error {
+		if err := stream.Context().Err(); err != nil {
+			return err
+		}
+		done++
+		return stream.Send(&pb.FlopAnalysisUpdate{
+			Flop: &pb.FlopResult{
+				Cards:   cardsToStrings(result.Flop.Cards),
+				Weight:  result.Flop.Weight,
+				Texture: result.Texture,
+				Equity:  result.Equity,
+				Hands:   handSharesToProto(result.HeroHands, result.VillainHands),
+			},
+			FlopsDone:  int32(done),
+			FlopsTotal: int32(len(flops)),
+		})
+	}
+
+	analysis, err := AnalyzeFlops(heroRange, villainRange, flops, int(req.NumSimulations), report)
+	if err != nil {
+		return err
+	}
+
+	textures := make([]*pb.TextureSummary, len(analysis.Textures))
+	for i, summary := range analysis.Textures {
+		textures[i] = textureSummaryToProto(summary)
+	}
+	return stream.Send(&pb.FlopAnalysisUpdate{
+		FlopsDone:  int32(done),
+		FlopsTotal: int32(len(flops)),
+		Textures:   textures,
+		Overall:    textureSummaryToProto(analysis.Overall),
+	})
+}
+
+// textureSummaryToProto converts a texture summary to its proto form
+func textureSummaryToProto(summary TextureSummary) *pb.TextureSummary {
+	return &pb.TextureSummary{
+		Texture: summary.Texture,
+		Flops:   int32(summary.Flops),
+		Weight:  summary.Weight,
+		Equity:  summary.Equity,
+		Hands:   handSharesToProto(summary.HeroHands, summary.VillainHands),
+	}
+}
+
+// handSharesToProto lists the hand categories either range makes, best first
+func handSharesToProto(hero, villain [9]float64) []*pb.HandShare {
+	shares := []*pb.HandShare{}
+	for rank := StraightFlush; rank >= HighCard; rank-- {
+		if hero[rank] > 0 || villain[rank] > 0 {
+			shares = append(shares, &pb.HandShare{
+				HandName: GetHandName(rank),
+				Hero:     hero[rank],
+				Villain:  villain[rank],
+			})
+		}
+	}
+	return shares
+}
+
+// EvaluateQuery answers a probability question written in the query language
+func (s *PokerServer) EvaluateQuery(ctx context.Context, req *pb.QueryRequest) (*pb.QueryResponse, error) {
+	result, err := EvaluateQuery(req.Query, QueryMethod(req.Method), int(req.NumSimulations))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.QueryResponse{
+		Probability:   result.Probability,
+		Hits:          result.Hits,
+		Deals:         result.Deals,
+		MethodUsed:    pb.QueryMethod(result.Method),
+		StandardError: result.StdError,
+	}, nil
+}
+
+// Showdown ranks every player on a shared board and splits the pot
+func (s *PokerServer) Showdown(ctx context.Context, req *pb.ShowdownRequest) (*pb.ShowdownResponse, error) {
+	communityCards, err := parseCards(req.CommunityCards, "community")
+	if err != nil {
+		return nil, err
+	}
+	players, err := parseShowdownSeats(req.Players)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := Showdown(players, communityCards, req.Pot, int(req.Button))
+	if err != nil {
+		return nil, err
+	}
+	return showdownToProto(result), nil
+}
+
+// DoubleBoardShowdown ranks every player on two boards and splits the pot
+// between them
+func (s *PokerServer) DoubleBoardShowdown(ctx context.Context, req *pb.DoubleBoardShowdownRequest) (*pb.DoubleBoardShowdownResponse, error) {
+	top, err := parseCards(req.TopBoardCards, "top board")
+	if err != nil {
+		return nil, err
+	}
+	bottom, err := parseCards(req.BottomBoardCards, "bottom board")
+	if err != nil {
+		return nil, err
+	}
+	players, err := parseShowdownSeats(req.Players)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := DoubleBoardShowdown(players, top, bottom, req.Pot, int(req.Button))
+	if err != nil {
+		return nil, err
+	}
+
+	payouts := make([]*pb.DoubleBoardPayout, len(result.Payouts))
+	for i, payout := range result.Payouts {
+		payouts[i] = &pb.DoubleBoardPayout{Seat: int32(payout.Seat), Payout: payout.Payout, Scoop: payout.Scoop}
+	}
+	return &pb.DoubleBoardShowdownResponse{
+		TopBoard:    showdownToProto(result.Top),
+		BottomBoard: showdownToProto(result.Bottom),
+		Payouts:     payouts,
+	}, nil
+}
+
+// CalculateDoubleBoardEquity simulates both boards to estimate each player's
+// share of the pot
+func (s *PokerServer) CalculateDoubleBoardEquity(ctx context.Context, req *pb.DoubleBoardEquityRequest) (*pb.DoubleBoardEquityResponse, error) {
+	top, err := parseCards(req.TopBoardCards, "top board")
+	if err != nil {
+		return nil, err
+	}
+	bottom, err := parseCards(req.BottomBoardCards, "bottom board")
+	if err != nil {
+		return nil, err
+	}
+	dead, err := parseCards(req.DeadCards, "dead")
+	if err != nil {
+		return nil, err
+	}
+	players, err := parseShowdownSeats(req.Players)
+	if err != nil {
+		return nil, err
 	}
 
 	numSimulations := int(req.NumSimulations)
@@ -128,13 +637,215 @@ func (s *PokerServer) CalculateProbability(ctx context.Context, req *pb.SimReque
 		numSimulations = 10000 // Default
 	}
 
-	// Run Monte Carlo simulation
-	winProb, tieProb, loseProb := MonteCarloSimulation(holeCards, communityCards, numSimulations)
+	equities, err := SimulateDoubleBoard(players, top, bottom, dead, numSimulations)
+	if err != nil {
+		return nil, err
+	}
 
-	return &pb.SimResponse{
-		WinProbability:  winProb,
-		TieProbability:  tieProb,
-		LoseProbability: loseProb,
-		SimulationsRun:  int32(numSimulations),
-	}, nil
+	result := make([]*pb.DoubleBoardEquity, len(equities))
+	for i, equity := range equities {
+		result[i] = &pb.DoubleBoardEquity{
+			Seat:              int32(equity.Seat),
+			Equity:            equity.Equity,
+			TopBoardEquity:    equity.Top,
+			BottomBoardEquity: equity.Bottom,
+			ScoopProbability:  equity.Scoop,
+		}
+	}
+	return &pb.DoubleBoardEquityResponse{Equities: result, SimulationsRun: int32(numSimulations)}, nil
+}
+
+// RecommendDiscard compares the equity of each way to keep two of three
+// Pineapple hole cards
+func (s *PokerServer) RecommendDiscard(ctx context.Context, req *pb.DiscardRequest) (*pb.DiscardResponse, error) {
+	holeCards, err := parseCards(req.HoleCards, "hole")
+	if err != nil {
+		return nil, err
+	}
+	communityCards, err := parseCards(req.CommunityCards, "community")
+	if err != nil {
+		return nil, err
+	}
+
+	numSimulations := int(req.NumSimulations)
+	if numSimulations <= 0 {
+		numSimulations = 10000 // Default
+	}
+
+	options, err := RecommendDiscard(holeCards, communityCards, req.Game, numSimulations, Estimator(req.Estimator))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*pb.DiscardOption, len(options))
+	for i, option := range options {
+		// Once the discard is made the hand plays as Hold'em
+		hand, err := s.EvaluateHand(ctx, &pb.HandRequest{
+			HoleCards:      cardsToStrings(option.Keep),
+			CommunityCards: cardsToStrings(communityCards),
+		})
+		if err != nil {
+			return nil, err
+		}
+		result[i] = &pb.DiscardOption{
+			DiscardCard:    CardToString(option.Discard),
+			KeepCards:      cardsToStrings(option.Keep),
+			Equity:         option.Equity(),
+			WinProbability: option.Result.Win,
+			TieProbability: option.Result.Tie,
+			StandardError:  option.Result.StdError,
+			Hand:           hand,
+		}
+	}
+	return &pb.DiscardResponse{DiscardCard: result[0].DiscardCard, Options: result}, nil
+}
+
+// ScoreOFC scores Open-Face Chinese Poker arrangements against each other
+func (s *PokerServer) ScoreOFC(ctx context.Context, req *pb.OFCRequest) (*pb.OFCResponse, error) {
+	arrangements := make([]OFCArrangement, len(req.Players))
+	for i, player := range req.Players {
+		arrangement := OFCArrangement{Seat: int(player.Seat)}
+		rows := []*[]Card{&arrangement.Top, &arrangement.Middle, &arrangement.Bottom}
+		for j, cardStrs := range [][]string{player.TopCards, player.MiddleCards, player.BottomCards} {
+			cards, err := parseCards(cardStrs, fmt.Sprintf("seat %d %s row", player.Seat, ofcRowNames[j]))
+			if err != nil {
+				return nil, err
+			}
+			*rows[j] = cards
+		}
+		arrangements[i] = arrangement
+	}
+
+	results, err := ScoreOFC(arrangements)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.OFCResponse{Results: make([]*pb.OFCResult, len(results))}
+	for i, result := range results {
+		rows := make([]*pb.OFCRow, len(result.Rows))
+		for j, row := range result.Rows {
+			rows[j] = &pb.OFCRow{
+				Name: ofcRowNames[j],
+				Hand: &pb.HandResponse{
+					BestHandName:  GetHandName(row.Hand.Rank),
+					HandRankValue: row.Hand.RankValue,
+					BestCards:     cardsToStrings(row.Hand.Cards),
+				},
+				Description: DescribeHand(row.Hand),
+				Royalty:     int32(row.Royalty),
+			}
+		}
+		matchups := make([]*pb.OFCMatchup, len(result.Matchups))
+		for j, matchup := range result.Matchups {
+			rowResults := make([]int32, len(matchup.Rows))
+			for k, row := range matchup.Rows {
+				rowResults[k] = int32(row)
+			}
+			matchups[j] = &pb.OFCMatchup{
+				Opponent: int32(matchup.Opponent),
+				Rows:     rowResults,
+				Scoop:    matchup.Scoop,
+				Points:   int32(matchup.Points),
+			}
+		}
+		resp.Results[i] = &pb.OFCResult{
+			Seat:             int32(result.Seat),
+			Rows:             rows,
+			Fouled:           result.Fouled,
+			Royalties:        int32(result.Royalties),
+			Fantasyland:      result.Fantasyland,
+			FantasylandCards: int32(result.FantasylandCards),
+			Matchups:         matchups,
+			Points:           int32(result.Points),
+		}
+	}
+	return resp, nil
+}
+
+// parseShowdownSeats parses the hole cards of every seat at showdown
+func parseShowdownSeats(seats []*pb.ShowdownSeat) ([]ShowdownPlayer, error) {
+	players := make([]ShowdownPlayer, len(seats))
+	for i, player := range seats {
+		holeCards, err := parseCards(player.HoleCards, fmt.Sprintf("seat %d hole", player.Seat))
+		if err != nil {
+			return nil, err
+		}
+		players[i] = ShowdownPlayer{Seat: int(player.Seat), HoleCards: holeCards}
+	}
+	return players, nil
+}
+
+// showdownToProto converts a showdown on one board to its proto form
+func showdownToProto(result ShowdownResult) *pb.ShowdownResponse {
+	results := make([]*pb.ShowdownResult, len(result.Entries))
+	for i, entry := range result.Entries {
+		results[i] = &pb.ShowdownResult{
+			Seat: int32(entry.Seat),
+			Hand: &pb.HandResponse{
+				BestHandName:  GetHandName(entry.Hand.Rank),
+				HandRankValue: entry.Hand.RankValue,
+				BestCards:     cardsToStrings(entry.Hand.Cards),
+			},
+			Description: DescribeHand(entry.Hand),
+			Place:       int32(entry.Place),
+			Payout:      entry.Payout,
+			OddChips:    entry.OddChips,
+		}
+	}
+	places := make([]*pb.TieGroup, len(result.Places))
+	for i, seats := range result.Places {
+		places[i] = &pb.TieGroup{Seats: seatsToProto(seats)}
+	}
+
+	return &pb.ShowdownResponse{
+		Results: results,
+		Places:  places,
+		Winners: seatsToProto(result.Winners),
+	}
+}
+
+// seatsToProto converts seat numbers to their proto form
+func seatsToProto(seats []int) []int32 {
+	result := make([]int32, len(seats))
+	for i, seat := range seats {
+		result[i] = int32(seat)
+	}
+	return result
+}
+
+// parseCards parses a list of card strings, naming the kind of card in errors
+func parseCards(cardStrs []string, kind string) ([]Card, error) {
+	cards, err := parseWildCards(cardStrs, kind)
+	if err != nil {
+		return nil, err
+	}
+	for _, card := range cards {
+		if IsJoker(card) {
+			return nil, fmt.Errorf("invalid %s card %s: jokers are only dealt with wild cards", kind, CardToString(card))
+		}
+	}
+	return cards, nil
+}
+
+// parseWildCards parses card strings that may include jokers
+func parseWildCards(cardStrs []string, kind string) ([]Card, error) {
+	cards := make([]Card, 0, len(cardStrs))
+	for _, cardStr := range cardStrs {
+		card, err := ParseCard(cardStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s card %s: %v", kind, cardStr, err)
+		}
+		cards = append(cards, card)
+	}
+	return cards, nil
+}
+
+// cardsToStrings formats cards for a response
+func cardsToStrings(cards []Card) []string {
+	result := make([]string, len(cards))
+	for i, card := range cards {
+		result[i] = CardToString(card)
+	}
+	return result
 }
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+)
+
+// ShowdownPlayer is a player still in the hand at showdown
+type ShowdownPlayer struct {
+	Seat      int
+	HoleCards []Card
+}
+
+// ShowdownEntry is one player's result at showdown
+type ShowdownEntry struct {
+	Seat     int
+	Hand     EvaluatedHand
+	Place    int   // 1 for the winners, tied players share a place
+	Payout   int64 // Chips won from the pot
+	OddChips int64 // Chips of the payout beyond an even split
+}
+
+// ShowdownResult orders the players at showdown and splits the pot
+type ShowdownResult struct {
+	Entries []ShowdownEntry // Best hand first, tied players in seat order from the button
+	Places  [][]int         // Seats grouped by place, best first
+	Winners []int           // Seats sharing the pot, in odd chip order
+}
+
+// Showdown ranks every player's best hand on a shared board and splits the
+// pot between the winners. Chips that do not split evenly go one at a time
+// to the winners closest to the left of the button.
+func Showdown(players []ShowdownPlayer, communityCards []Card, pot int64, button int) (ShowdownResult, error) {
+	if len(players) < 2 {
+		return ShowdownResult{}, fmt.Errorf("need at least 2 players, got %d", len(players))
+	}
+	if len(communityCards) < 3 || len(communityCards) > 5 {
+		return ShowdownResult{}, fmt.Errorf("need 3 to 5 community cards, got %d", len(communityCards))
+	}
+	if pot < 0 {
+		return ShowdownResult{}, fmt.Errorf("pot cannot be negative: %d", pot)
+	}
+
+	seats := make(map[int]bool)
+	dealt := [][]Card{communityCards}
+	for _, player := range players {
+		if seats[player.Seat] {
+			return ShowdownResult{}, fmt.Errorf("seat %d appears twice", player.Seat)
+		}
+		seats[player.Seat] = true
+		if len(player.HoleCards) != 2 {
+			return ShowdownResult{}, fmt.Errorf("seat %d needs exactly 2 hole cards, got %d", player.Seat, len(player.HoleCards))
+		}
+		dealt = append(dealt, player.HoleCards)
+	}
+	if err := checkDistinct(dealt...); err != nil {
+		return ShowdownResult{}, err
+	}
+
+	var result ShowdownResult
+	for _, player := range players {
+		hand := EvaluateBestHand(append(append([]Card{}, player.HoleCards...), communityCards...))
+		result.Entries = append(result.Entries, ShowdownEntry{Seat: player.Seat, Hand: hand})
+	}
+
+	// Best hand first; ties in seat order starting left of the button
+	sort.Slice(result.Entries, func(i, j int) bool {
+		a, b := result.Entries[i], result.Entries[j]
+		if a.Hand.RankValue != b.Hand.RankValue {
+			return a.Hand.RankValue > b.Hand.RankValue
+		}
+		return seatsFromButton(a.Seat, button) < seatsFromButton(b.Seat, button)
+	})
+
+	place := 0
+	for i := range result.Entries {
+		if i == 0 || result.Entries[i].Hand.RankValue != result.Entries[i-1].Hand.RankValue {
+			place++
+			result.Places = append(result.Places, []int{})
+		}
+		result.Entries[i].Place = place
+		result.Places[place-1] = append(result.Places[place-1], result.Entries[i].Seat)
+	}
+	result.Winners = result.Places[0]
+
+	share := pot / int64(len(result.Winners))
+	oddChips := pot % int64(len(result.Winners))
+	for i := range result.Winners {
+		entry := &result.Entries[i]
+		entry.Payout = share
+		if int64(i) < oddChips {
+			entry.Payout++
+			entry.OddChips = 1
+		}
+	}
+
+	return result, nil
+}
+
+// seatsFromButton orders seats clockwise starting with the seat left of the
+// button, which comes first; seats numbered at or below the button come last
+func seatsFromButton(seat, button int) int {
+	if seat > button {
+		return seat - button
+	}
+	// Past the highest seat number the order wraps around to the lowest
+	return seat - button + 1<<30
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"fmt"
+	"math"
+)
+
+// Indexes into the hand potential tables
+const (
+	handAhead = iota
+	handTied
+	handBehind
+)
+
+// HandStrengthResult holds the classic hand strength metrics
+type HandStrengthResult struct {
+	Ahead             int     // Opponent combos hero currently beats
+	Tied              int     // Opponent combos hero currently ties
+	Behind            int     // Opponent combos currently beating hero
+	HandStrength      float64 // Immediate hand strength against one opponent
+	PositivePotential float64 // Chance of getting ahead when behind (or tied)
+	NegativePotential float64 // Chance of falling behind when ahead (or tied)
+	Effective         float64 // Effective hand strength (EHS)
+	Optimistic        float64 // EHS ignoring negative potential
+}
+
+// CalculateHandStrength computes hand strength and hand potential for hero on
+// a flop or turn against every possible opponent holding. Potential looks one
+// card ahead, to the next street.
+func CalculateHandStrength(holeCards []Card, communityCards []Card, numOpponents int) (HandStrengthResult, error) {
+	if len(holeCards) != 2 {
+		return HandStrengthResult{}, fmt.Errorf("need exactly 2 hole cards, got %d", len(holeCards))
+	}
+	if len(communityCards) != 3 && len(communityCards) != 4 {
+		return HandStrengthResult{}, fmt.Errorf("need 3 or 4 community cards, got %d", len(communityCards))
+	}
+	if err := checkDistinct(holeCards, communityCards); err != nil {
+		return HandStrengthResult{}, err
+	}
+	if numOpponents < 1 {
+		numOpponents = 1
+	}
+
+	known := append(append([]Card{}, holeCards...), communityCards...)
+	opponents := removeBlocked(allCombos(), known)
+	nextCards := remainingDeck(known)
+
+	// Hero's hand now and after each possible next card
+	heroNow := EvaluateBestHand(known).RankValue
+	heroNext := make(map[Card]int32, len(nextCards))
+	for _, card := range nextCards {
+		heroNext[card] = EvaluateBestHand(append(append([]Card{}, known...), card)).RankValue
+	}
+
+	// potential[now][next] counts (opponent, next card) pairs, Billings et al.
+	var potential [3][3]int
+	var totals [3]int
+	var result HandStrengthResult
+
+	for _, opponent := range opponents {
+		oppCards := append([]Card{opponent[0], opponent[1]}, communityCards...)
+		now := compareValues(heroNow, EvaluateBestHand(oppCards).RankValue)
+		switch now {
+		case handAhead:
+			result.Ahead++
+		case handTied:
+			result.Tied++
+		default:
+			result.Behind++
+		}
+
+		for _, card := range nextCards {
+			if card == opponent[0] || card == opponent[1] {
+				continue
+			}
+			oppNext := EvaluateBestHand(append(oppCards, card)).RankValue
+			potential[now][compareValues(heroNext[card], oppNext)]++
+			totals[now]++
+		}
+	}
+
+	total := float64(result.Ahead + result.Tied + result.Behind)
+	result.HandStrength = (float64(result.Ahead) + float64(result.Tied)/2) / total
+
+	if denom := float64(totals[handBehind]) + float64(totals[handTied])/2; denom > 0 {
+		result.PositivePotential = (float64(potential[handBehind][handAhead]) +
+			float64(potential[handBehind][handTied])/2 +
+			float64(potential[handTied][handAhead])/2) / denom
+	}
+	if denom := float64(totals[handAhead]) + float64(totals[handTied])/2; denom > 0 {
+		result.NegativePotential = (float64(potential[handAhead][handBehind]) +
+			float64(potential[handTied][handBehind])/2 +
+			float64(potential[handAhead][handTied])/2) / denom
+	}
+
+	// Against several opponents hero must beat each one
+	strength := math.Pow(result.HandStrength, float64(numOpponents))
+	result.Effective = strength*(1-result.NegativePotential) + (1-strength)*result.PositivePotential
+	result.Optimistic = strength + (1-strength)*result.PositivePotential
+
+	return result, nil
+}
+
+// compareValues classifies hero's rank value against an opponent's
+func compareValues(hero, opponent int32) int {
+	if hero > opponent {
+		return handAhead
+	} else if hero == opponent {
+		return handTied
+	}
+	return handBehind
+}
@@ -0,0 +1,207 @@
+package main
+
+import "fmt"
+
+// Suit patterns of a board
+const (
+	Monotone = "Monotone"
+	TwoTone  = "Two-Tone"
+	Rainbow  = "Rainbow"
+)
+
+// Straight connectivity of a board
+const (
+	Connected     = "Connected"
+	SemiConnected = "Semi-Connected"
+	Disconnected  = "Disconnected"
+)
+
+// Weights of the wetness score, which add up to 100
+const (
+	flushWetness    = 40
+	straightWetness = 45
+	pairedWetness   = 15
+)
+
+// HandCount is a hand or draw name with the number of holdings that make it
+type HandCount struct {
+	Name   string
+	Combos int
+}
+
+// BoardTexture describes the structure of a flop, turn or river
+type BoardTexture struct {
+	Pairing          string // Best hand on the board itself, e.g. "One Pair"
+	Paired           bool
+	Trips            bool
+	SuitPattern      string
+	MaxSuitCount     int
+	FlushPossible    bool
+	Connectivity     string
+	StraightWindows  int // Straights some holding can make
+	StraightPossible bool
+	HighCard         int
+	Wetness          int // 0 (dry) to 100 (wet)
+	PossibleHands    []HandCount
+	PossibleDraws    []HandCount
+}
+
+// AnalyzeBoard classifies the texture of a board of 3 to 5 community cards
+func AnalyzeBoard(communityCards []Card) (BoardTexture, error) {
+	if len(communityCards) < 3 || len(communityCards) > 5 {
+		return BoardTexture{}, fmt.Errorf("need 3 to 5 community cards, got %d", len(communityCards))
+	}
+
+	texture := boardStructure(communityCards)
+	texture.PossibleHands, texture.PossibleDraws = possibleHoldings(communityCards)
+	return texture, nil
+}
+
+// boardStructure classifies pairing, suits, connectivity and wetness, leaving
+// the possible hands and draws empty
+func boardStructure(communityCards []Card) BoardTexture {
+	cardsToCome := len(communityCards) < 5
+
+	var texture BoardTexture
+
+	// Pairing
+	rankCounts := make(map[int]int)
+	for _, card := range communityCards {
+		rankCounts[card.Rank]++
+		if card.Rank > texture.HighCard {
+			texture.HighCard = card.Rank
+		}
+	}
+	pairs := 0
+	for _, count := range rankCounts {
+		if count >= 2 {
+			pairs++
+		}
+		if count >= 3 {
+			texture.Trips = true
+		}
+	}
+	texture.Paired = pairs > 0
+	texture.Pairing = GetHandName(boardPairing(rankCounts))
+
+	// Suits
+	suitCounts := make(map[string]int)
+	for _, card := range communityCards {
+		suitCounts[card.Suit]++
+		if suitCounts[card.Suit] > texture.MaxSuitCount {
+			texture.MaxSuitCount = suitCounts[card.Suit]
+		}
+	}
+	switch {
+	case texture.MaxSuitCount == len(communityCards):
+		texture.SuitPattern = Monotone
+	case texture.MaxSuitCount == 1:
+		texture.SuitPattern = Rainbow
+	default:
+		texture.SuitPattern = TwoTone
+	}
+	texture.FlushPossible = texture.MaxSuitCount >= 3
+
+	// Straight windows holding three board ranks can be completed by two hole
+	// cards; windows holding two can still become straight draws
+	drawWindows := 0
+	for _, window := range straightWindows {
+		count := 0
+		for _, rank := range window {
+			if rankCounts[rank] > 0 {
+				count++
+			}
+		}
+		if count >= 3 {
+			texture.StraightWindows++
+		} else if count == 2 && cardsToCome {
+			drawWindows++
+		}
+	}
+	texture.StraightPossible = texture.StraightWindows > 0
+	switch {
+	case texture.StraightWindows >= 3:
+		texture.Connectivity = Connected
+	case texture.StraightWindows > 0:
+		texture.Connectivity = SemiConnected
+	default:
+		texture.Connectivity = Disconnected
+	}
+
+	// Wetness: how many strong hands and draws the board supports
+	wetness := 0
+	if texture.FlushPossible {
+		wetness += flushWetness
+	} else if texture.MaxSuitCount == 2 && cardsToCome {
+		wetness += flushWetness / 2
+	}
+	straightScore := 10*texture.StraightWindows + 3*drawWindows
+	if straightScore > straightWetness {
+		straightScore = straightWetness
+	}
+	wetness += straightScore
+	if texture.Paired {
+		wetness += pairedWetness
+	}
+	texture.Wetness = wetness
+	return texture
+}
+
+// boardPairing returns the best pairing the board ranks make on their own
+func boardPairing(rankCounts map[int]int) HandRank {
+	pairs, trips, quads := 0, 0, 0
+	for _, count := range rankCounts {
+		switch count {
+		case 2:
+			pairs++
+		case 3:
+			trips++
+		case 4:
+			quads++
+		}
+	}
+	switch {
+	case quads > 0:
+		return FourOfAKind
+	case trips > 0 && pairs > 0:
+		return FullHouse
+	case trips > 0:
+		return ThreeOfAKind
+	case pairs >= 2:
+		return TwoPair
+	case pairs == 1:
+		return OnePair
+	}
+	return HighCard
+}
+
+// possibleHoldings enumerates every two-card holding on the board and counts
+// how many make each hand category and each draw
+func possibleHoldings(communityCards []Card) ([]HandCount, []HandCount) {
+	handCombos := make(map[HandRank]int)
+	drawCombos := make(map[string]int)
+
+	for _, combo := range removeBlocked(allCombos(), communityCards) {
+		holeCards := []Card{combo[0], combo[1]}
+		best := EvaluateBestHand(append(append([]Card{}, holeCards...), communityCards...))
+		handCombos[best.Rank]++
+		for _, draw := range FindDraws(holeCards, communityCards, best) {
+			drawCombos[draw.Name]++
+		}
+	}
+
+	hands := []HandCount{}
+	for rank := StraightFlush; rank >= HighCard; rank-- {
+		if handCombos[rank] > 0 {
+			hands = append(hands, HandCount{Name: GetHandName(rank), Combos: handCombos[rank]})
+		}
+	}
+
+	draws := []HandCount{}
+	for _, name := range []string{FlushDraw, OpenEndedStraight, DoubleGutshot, Gutshot, BackdoorFlushDraw, BackdoorStraightDraw, Overcards} {
+		if drawCombos[name] > 0 {
+			draws = append(draws, HandCount{Name: name, Combos: drawCombos[name]})
+		}
+	}
+	return hands, draws
+}
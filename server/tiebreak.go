@@ -0,0 +1,183 @@
+package main
+
+import "fmt"
+
+// Levels at which a comparison between two hands is decided
+const (
+	LevelCategory  = "Category"
+	LevelPrimary   = "Primary Rank"
+	LevelSecondary = "Secondary Rank"
+	LevelKicker    = "Kicker"
+	LevelTie       = "Tie"
+)
+
+// TieBreak explains how two hands were separated, or why they tie
+type TieBreak struct {
+	Level       string
+	Kicker      int    // 1-based kicker that decided, 0 unless Level is LevelKicker
+	Hand1Cards  []Card // Cards of each hand at the deciding level
+	Hand2Cards  []Card
+	Hand1Board  bool // Hand 1's best five are all community cards
+	Hand2Board  bool
+	Explanation string
+}
+
+// ExplainTieBreak compares two evaluated hands level by level: category,
+// then the ranks that define the hand, then kickers. When one hand has
+// fewer cards, its first missing kicker loses to any card.
+func ExplainTieBreak(hand1, hand2 EvaluatedHand, board1, board2 []Card) TieBreak {
+	tieBreak := TieBreak{
+		Hand1Board: boardPlays(hand1, board1),
+		Hand2Board: boardPlays(hand2, board2),
+	}
+
+	if hand1.Rank != hand2.Rank {
+		tieBreak.Level = LevelCategory
+		tieBreak.Hand1Cards, tieBreak.Hand2Cards = hand1.Cards, hand2.Cards
+		winner, high, low := 1, hand1, hand2
+		if hand2.Rank > hand1.Rank {
+			winner, high, low = 2, hand2, hand1
+		}
+		tieBreak.Explanation = fmt.Sprintf("Hand %d wins on the category: %s beats %s", winner, GetHandName(high.Rank), GetHandName(low.Rank))
+		return tieBreak
+	}
+
+	keys1, keys2 := tieBreakRanks(hand1), tieBreakRanks(hand2)
+	levels := tieBreakLevels(hand1.Rank)
+	shared := minInt(len(keys1), len(keys2))
+	for i := 0; i < shared; i++ {
+		if keys1[i] == keys2[i] {
+			continue
+		}
+
+		tieBreak.Level = levels[i]
+		if levels[i] == LevelKicker {
+			tieBreak.Kicker = i - countLevels(levels, LevelPrimary, LevelSecondary) + 1
+		}
+		tieBreak.Hand1Cards = cardsOfRank(hand1.Cards, keys1[i])
+		tieBreak.Hand2Cards = cardsOfRank(hand2.Cards, keys2[i])
+
+		high, low := keys1[i], keys2[i]
+		winner := 1
+		if keys2[i] > keys1[i] {
+			high, low, winner = keys2[i], keys1[i], 2
+		}
+		tieBreak.Explanation = fmt.Sprintf("Both have %s; hand %d wins on the %s: %s beats %s",
+			GetHandName(hand1.Rank), winner, levelName(tieBreak), RankToString(high), RankToString(low))
+		return tieBreak
+	}
+
+	if len(keys1) != len(keys2) {
+		tieBreak.Level = LevelKicker
+		if shared < len(levels) {
+			tieBreak.Level = levels[shared]
+		}
+		if tieBreak.Level == LevelKicker {
+			tieBreak.Kicker = shared - countLevels(levels, LevelPrimary, LevelSecondary) + 1
+		}
+		var winner, extra int
+		if len(keys1) > len(keys2) {
+			winner, extra = 1, keys1[shared]
+			tieBreak.Hand1Cards, tieBreak.Hand2Cards = cardsOfRank(hand1.Cards, extra), []Card{}
+		} else {
+			winner, extra = 2, keys2[shared]
+			tieBreak.Hand1Cards, tieBreak.Hand2Cards = []Card{}, cardsOfRank(hand2.Cards, extra)
+		}
+		tieBreak.Explanation = fmt.Sprintf("Both have %s; hand %d wins on the %s: %s against no card",
+			GetHandName(hand1.Rank), winner, levelName(tieBreak), RankToString(extra))
+		return tieBreak
+	}
+
+	tieBreak.Level = LevelTie
+	if tieBreak.Hand1Board && tieBreak.Hand2Board {
+		tieBreak.Explanation = fmt.Sprintf("The board plays for both: %s", DescribeHand(hand1))
+	} else {
+		tieBreak.Explanation = fmt.Sprintf("Both hands are %s", DescribeHand(hand1))
+	}
+	return tieBreak
+}
+
+// tieBreakRanks returns the ranks compared after the category, most
+// significant first. Straights compare on their high card alone.
+func tieBreakRanks(hand EvaluatedHand) []int {
+	if hand.Rank == Straight || hand.Rank == StraightFlush {
+		_, high := checkStraight(hand.Cards)
+		return []int{high}
+	}
+	return groupedRanks(hand.Cards)
+}
+
+// tieBreakLevels names each position of tieBreakRanks for a category
+func tieBreakLevels(rank HandRank) []string {
+	switch rank {
+	case StraightFlush, Straight:
+		return []string{LevelPrimary}
+	case FourOfAKind:
+		return []string{LevelPrimary, LevelKicker}
+	case FullHouse:
+		return []string{LevelPrimary, LevelSecondary}
+	case ThreeOfAKind:
+		return []string{LevelPrimary, LevelKicker, LevelKicker}
+	case TwoPair:
+		return []string{LevelPrimary, LevelSecondary, LevelKicker}
+	case OnePair:
+		return []string{LevelPrimary, LevelKicker, LevelKicker, LevelKicker}
+	}
+	// Flush and high card: the top card, then four kickers
+	return []string{LevelPrimary, LevelKicker, LevelKicker, LevelKicker, LevelKicker}
+}
+
+// countLevels counts the positions taken by the given levels
+func countLevels(levels []string, names ...string) int {
+	count := 0
+	for _, level := range levels {
+		for _, name := range names {
+			if level == name {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+// levelName describes a deciding level in words, e.g. "second kicker"
+func levelName(tieBreak TieBreak) string {
+	switch tieBreak.Level {
+	case LevelPrimary:
+		return "primary rank"
+	case LevelSecondary:
+		return "secondary rank"
+	case LevelKicker:
+		ordinals := []string{"first", "second", "third", "fourth"}
+		if tieBreak.Kicker > len(ordinals) {
+			return "kicker"
+		}
+		return ordinals[tieBreak.Kicker-1] + " kicker"
+	}
+	return tieBreak.Level
+}
+
+// cardsOfRank returns the cards of a given rank
+func cardsOfRank(cards []Card, rank int) []Card {
+	matching := []Card{}
+	for _, card := range cards {
+		if card.Rank == rank {
+			matching = append(matching, card)
+		}
+	}
+	return matching
+}
+
+// boardPlays reports whether a best hand uses only community cards
+func boardPlays(hand EvaluatedHand, board []Card) bool {
+	onBoard := make(map[Card]bool, len(board))
+	for _, card := range board {
+		onBoard[card] = true
+	}
+	for _, card := range hand.Cards {
+		if !onBoard[card] {
+			return false
+		}
+	}
+	return len(hand.Cards) == 5
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	pbv1 "github.com/mispice/Poker-dist-assignment/proto/v1"
+)
+
+func TestExplainTieBreak(t *testing.T) {
+	tests := []struct {
+		name         string
+		hand1, hand2 string
+		level        string
+		kicker       int
+		explanation  string
+	}{
+		{"category", "Ah Ad Kc Ks 2h", "Qh Qd Jc 9s 2c", LevelCategory, 0, "Hand 1 wins on the category: Two Pair beats One Pair"},
+		{"second kicker", "8h 8d Ac Ks 3h", "8c 8s Ad Qh 2c", LevelKicker, 2, "Both have One Pair; hand 1 wins on the second kicker: K beats Q"},
+		{"tie", "8h 8d Ac Ks 3h", "8c 8s Ad Kh 3c", LevelTie, 0, "Both hands are One Pair, 8s (A-K-3 kickers)"},
+		// Partial hands: the hand with more cards wins on its extra kicker,
+		// whichever side it is on
+		{"partial longer first", "Ah Kd 7c", "As Kc", LevelKicker, 2, "Both have High Card; hand 1 wins on the second kicker: 7 against no card"},
+		{"partial longer second", "Qh Qd", "Qc Qs Ad", LevelKicker, 1, "Both have One Pair; hand 2 wins on the first kicker: A against no card"},
+	}
+	for _, tt := range tests {
+		evaluate := func(hand string) EvaluatedHand {
+			cards := mustParseHand(t, hand)
+			if len(cards) < 5 {
+				return EvaluatePartialHand(cards)
+			}
+			return evaluateFiveCards(cards)
+		}
+		got := ExplainTieBreak(evaluate(tt.hand1), evaluate(tt.hand2), nil, nil)
+		if got.Level != tt.level || got.Kicker != tt.kicker {
+			t.Errorf("%s: got level %q kicker %d, want %q kicker %d", tt.name, got.Level, got.Kicker, tt.level, tt.kicker)
+		}
+		if got.Explanation != tt.explanation {
+			t.Errorf("%s: got %q, want %q", tt.name, got.Explanation, tt.explanation)
+		}
+	}
+}
+
+func TestCompareHandsPartial(t *testing.T) {
+	// A three-card hand against a two-card hand once indexed past the
+	// shorter hand's ranks and panicked
+	req := &pbv1.CompareRequest{
+		Hand1: &pbv1.HandRequest{
+			HoleCards:      cardsToProto(mustParseHand(t, "Ah Kd")),
+			CommunityCards: cardsToProto(mustParseHand(t, "7c")),
+		},
+		Hand2: &pbv1.HandRequest{HoleCards: cardsToProto(mustParseHand(t, "As Kc"))},
+	}
+	resp, err := NewPokerServerV1().CompareHands(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Winner != 1 {
+		t.Errorf("got winner %d, want 1", resp.Winner)
+	}
+	if resp.TieBreak != nil {
+		t.Errorf("partial hands got a tie-break explanation: %v", resp.TieBreak)
+	}
+}
@@ -0,0 +1,160 @@
+package main
+
+import "fmt"
+
+// Street names, in dealing order
+const (
+	Preflop = "Preflop"
+	Flop    = "Flop"
+	Turn    = "Turn"
+	River   = "River"
+)
+
+// streetBoardSizes is the number of community cards dealt by each street
+var streetBoardSizes = []struct {
+	Street string
+	Cards  int
+}{
+	{Preflop, 0},
+	{Flop, 3},
+	{Turn, 4},
+	{River, 5},
+}
+
+// StreetEquity is every player's equity once a street has been dealt
+type StreetEquity struct {
+	Street      string
+	Board       []Card
+	NewCards    []Card    // Cards dealt on this street
+	Equities    []float64 // Share of the pot each player expects, ties split
+	Leader      int       // 1-based player with the most equity, 0 when tied
+	LeadChanged bool      // Leader differs from the previous street
+}
+
+// EquityTimeline computes each player's equity on every street up to the
+// known board, enumerating all runouts. Streets where the leader changes are
+// marked, with the cards that were dealt on them.
+func EquityTimeline(hands [][]Card, communityCards []Card) ([]StreetEquity, error) {
+	if len(hands) < 2 {
+		return nil, fmt.Errorf("need at least 2 players, got %d", len(hands))
+	}
+	if len(communityCards) != 0 && (len(communityCards) < 3 || len(communityCards) > 5) {
+		return nil, fmt.Errorf("need 0 or 3 to 5 community cards, got %d", len(communityCards))
+	}
+
+	known := append([]Card{}, communityCards...)
+	for i, hand := range hands {
+		if len(hand) != 2 {
+			return nil, fmt.Errorf("player %d needs exactly 2 hole cards, got %d", i+1, len(hand))
+		}
+		known = append(known, hand...)
+	}
+	if err := checkDistinct(known); err != nil {
+		return nil, err
+	}
+
+	timeline := []StreetEquity{}
+	for _, street := range streetBoardSizes {
+		if street.Cards > len(communityCards) {
+			break
+		}
+
+		board := communityCards[:street.Cards]
+		entry := StreetEquity{
+			Street:   street.Street,
+			Board:    board,
+			Equities: enumerateEquity(hands, board),
+		}
+		if len(timeline) > 0 {
+			previous := timeline[len(timeline)-1]
+			entry.NewCards = board[len(previous.Board):]
+		}
+
+		best := -1.0
+		for i, equity := range entry.Equities {
+			switch {
+			case equity > best+1e-9:
+				best = equity
+				entry.Leader = i + 1
+			case equity > best-1e-9:
+				entry.Leader = 0
+			}
+		}
+		if len(timeline) > 0 {
+			entry.LeadChanged = entry.Leader != timeline[len(timeline)-1].Leader
+		}
+
+		timeline = append(timeline, entry)
+	}
+
+	return timeline, nil
+}
+
+// enumerateEquity deals every completion of the board and returns each
+// player's share of the pot, splitting ties evenly
+func enumerateEquity(hands [][]Card, communityCards []Card) []float64 {
+	dead := append([]Card{}, communityCards...)
+	for _, hand := range hands {
+		dead = append(dead, hand...)
+	}
+	deck := remainingDeck(dead)
+	cardsNeeded := 5 - len(communityCards)
+
+	// Each player's 7 cards, with the runout filled in at the end
+	sevens := make([][]Card, len(hands))
+	for i, hand := range hands {
+		sevens[i] = append(append(append([]Card{}, hand...), communityCards...), make([]Card, cardsNeeded)...)
+	}
+
+	shares := make([]float64, len(hands))
+	values := make([]int32, len(hands))
+	runouts := 0
+
+	// idx walks every cardsNeeded-combination of the deck in order
+	idx := make([]int, cardsNeeded)
+	for i := range idx {
+		idx[i] = i
+	}
+	for {
+		for _, seven := range sevens {
+			for j, k := range idx {
+				seven[7-cardsNeeded+j] = deck[k]
+			}
+		}
+
+		best := int32(-1)
+		winners := 0
+		for i, seven := range sevens {
+			values[i] = handValue(seven)
+			if values[i] > best {
+				best, winners = values[i], 1
+			} else if values[i] == best {
+				winners++
+			}
+		}
+		for i, value := range values {
+			if value == best {
+				shares[i] += 1 / float64(winners)
+			}
+		}
+		runouts++
+
+		// Advance to the next combination
+		j := cardsNeeded - 1
+		for j >= 0 && idx[j] == len(deck)-cardsNeeded+j {
+			j--
+		}
+		if j < 0 {
+			break
+		}
+		idx[j]++
+		for k := j + 1; k < cardsNeeded; k++ {
+			idx[k] = idx[k-1] + 1
+		}
+	}
+
+	for i := range shares {
+		shares[i] /= float64(runouts)
+	}
+	return shares
+}
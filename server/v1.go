@@ -0,0 +1,362 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	pbv1 "github.com/mispice/Poker-dist-assignment/proto/v1"
+)
+
+// PokerServerV1 implements the versioned poker.v1 PokerService
+type PokerServerV1 struct {
+	pbv1.UnimplementedPokerServiceServer
+}
+
+// NewPokerServerV1 creates a new PokerServerV1 instance
+func NewPokerServerV1() *PokerServerV1 {
+	return &PokerServerV1{}
+}
+
+// Suit letters by their proto enum, and back
+var (
+	suitLetters = map[pbv1.Suit]string{
+		pbv1.Suit_SUIT_HEARTS: "H", pbv1.Suit_SUIT_DIAMONDS: "D", pbv1.Suit_SUIT_CLUBS: "C", pbv1.Suit_SUIT_SPADES: "S",
+	}
+	protoSuits = map[string]pbv1.Suit{
+		"H": pbv1.Suit_SUIT_HEARTS, "D": pbv1.Suit_SUIT_DIAMONDS, "C": pbv1.Suit_SUIT_CLUBS, "S": pbv1.Suit_SUIT_SPADES,
+	}
+)
+
+// Tie-break levels by name, and back
+var (
+	protoLevels = map[string]pbv1.TieBreakLevel{
+		LevelCategory:  pbv1.TieBreakLevel_TIE_BREAK_LEVEL_CATEGORY,
+		LevelPrimary:   pbv1.TieBreakLevel_TIE_BREAK_LEVEL_PRIMARY_RANK,
+		LevelSecondary: pbv1.TieBreakLevel_TIE_BREAK_LEVEL_SECONDARY_RANK,
+		LevelKicker:    pbv1.TieBreakLevel_TIE_BREAK_LEVEL_KICKER,
+		LevelTie:       pbv1.TieBreakLevel_TIE_BREAK_LEVEL_TIE,
+	}
+	levelNames = map[pbv1.TieBreakLevel]string{}
+)
+
+// Registered variant names by their proto enum
+var protoVariantNames = map[pbv1.Variant]string{
+	pbv1.Variant_VARIANT_TEXAS_HOLDEM:   VariantHoldem,
+	pbv1.Variant_VARIANT_OMAHA:          VariantOmaha,
+	pbv1.Variant_VARIANT_SHORT_DECK:     VariantShortDeck,
+	pbv1.Variant_VARIANT_RAZZ:           VariantRazz,
+	pbv1.Variant_VARIANT_DEUCE_TO_SEVEN: VariantDeuceToSeven,
+}
+
+func init() {
+	for name, level := range protoLevels {
+		levelNames[level] = name
+	}
+}
+
+// EvaluateHand evaluates the best poker hand from hole cards and community cards
+func (s *PokerServerV1) EvaluateHand(ctx context.Context, req *pbv1.HandRequest) (*pbv1.HandResponse, error) {
+	_, resp, err := evaluateV1(req)
+	return resp, err
+}
+
+// CompareHands compares two poker hands and explains what decided it
+func (s *PokerServerV1) CompareHands(ctx context.Context, req *pbv1.CompareRequest) (*pbv1.CompareResponse, error) {
+	hand1, hand1Result, err := evaluateV1(req.Hand1)
+	if err != nil {
+		return nil, fmt.Errorf("error evaluating hand 1: %v", err)
+	}
+	hand2, hand2Result, err := evaluateV1(req.Hand2)
+	if err != nil {
+		return nil, fmt.Errorf("error evaluating hand 2: %v", err)
+	}
+
+	variant1, variant2 := defaultVariant(req.Hand1.Variant), defaultVariant(req.Hand2.Variant)
+	if variant1 != variant2 {
+		return nil, fmt.Errorf("cannot compare a %s hand with a %s hand",
+			protoVariantNames[variant1], protoVariantNames[variant2])
+	}
+	if wildFromProto(req.Hand1.Wild) != wildFromProto(req.Hand2.Wild) {
+		return nil, fmt.Errorf("cannot compare hands played with different wild cards")
+	}
+
+	var winner int32
+	switch compareValues(hand1.RankValue, hand2.RankValue) {
+	case handAhead:
+		winner = 1
+	case handBehind:
+		winner = 2
+	}
+
+	resp := &pbv1.CompareResponse{
+		Winner:      winner,
+		Hand1Result: hand1Result,
+		Hand2Result: hand2Result,
+	}
+
+	// Tie-breaks are only explained for the usual hand ranking of complete hands
+	variant, _ := requestVariant(req.Hand1.Variant, req.Hand1.Wild)
+	if !variant.StandardRanking() || len(hand1.Cards) < 5 || len(hand2.Cards) < 5 {
+		return resp, nil
+	}
+
+	// Request cards were validated by evaluateV1
+	board1, _ := cardsFromProto(req.Hand1.CommunityCards, "community")
+	board2, _ := cardsFromProto(req.Hand2.CommunityCards, "community")
+	tieBreak := ExplainTieBreak(hand1, hand2, board1, board2)
+	resp.TieBreak = &pbv1.TieBreak{
+		Level:           protoLevels[tieBreak.Level],
+		Kicker:          int32(tieBreak.Kicker),
+		Hand1Cards:      cardsToProto(tieBreak.Hand1Cards),
+		Hand2Cards:      cardsToProto(tieBreak.Hand2Cards),
+		Hand1BoardPlays: tieBreak.Hand1Board,
+		Hand2BoardPlays: tieBreak.Hand2Board,
+		Explanation:     tieBreak.Explanation,
+	}
+	return resp, nil
+}
+
+// CalculateProbability runs Monte Carlo simulation to calculate win probability
+func (s *PokerServerV1) CalculateProbability(ctx context.Context, req *pbv1.SimRequest) (*pbv1.SimResponse, error) {
+	variant, err := requestVariant(req.Variant, req.Wild)
+	if err != nil {
+		return nil, err
+	}
+	holeCards, err := cardsFromProto(req.HoleCards, "hole")
+	if err != nil {
+		return nil, err
+	}
+	communityCards, err := cardsFromProto(req.CommunityCards, "community")
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate inputs
+	if len(holeCards) != variant.HoleCards() {
+		return nil, fmt.Errorf("need exactly %d hole cards, got %d", variant.HoleCards(), len(holeCards))
+	}
+	if err := variant.Validate(holeCards, communityCards); err != nil {
+		return nil, err
+	}
+
+	numSimulations := int(req.NumSimulations)
+	if numSimulations <= 0 {
+		numSimulations = 10000 // Default
+	}
+
+	// The variance-reduced estimators deal Hold'em without wild cards only
+	var result SimulationResult
+	if variant.Name() == VariantHoldem {
+		result = RunSimulation(holeCards, communityCards, nil, numSimulations, Estimator(req.Estimator))
+	} else {
+		result = SimulateVariant(variant, holeCards, communityCards, numSimulations)
+	}
+
+	return &pbv1.SimResponse{
+		WinProbability:  result.Win,
+		TieProbability:  result.Tie,
+		LoseProbability: result.Lose,
+		SimulationsRun:  int32(result.Trials),
+		EstimatorUsed:   pbv1.Estimator(result.Estimator),
+		StandardError:   result.StdError,
+	}, nil
+}
+
+// evaluateV1 evaluates a hand request, returning the best hand and its response
+func evaluateV1(req *pbv1.HandRequest) (EvaluatedHand, *pbv1.HandResponse, error) {
+	if req == nil {
+		return EvaluatedHand{}, nil, fmt.Errorf("missing hand")
+	}
+	variant, err := requestVariant(req.Variant, req.Wild)
+	if err != nil {
+		return EvaluatedHand{}, nil, err
+	}
+	holeCards, err := cardsFromProto(req.HoleCards, "hole")
+	if err != nil {
+		return EvaluatedHand{}, nil, err
+	}
+	communityCards, err := cardsFromProto(req.CommunityCards, "community")
+	if err != nil {
+		return EvaluatedHand{}, nil, err
+	}
+
+	if len(holeCards)+len(communityCards) == 0 {
+		return EvaluatedHand{}, nil, fmt.Errorf("need at least 1 card")
+	}
+	if err := variant.Validate(holeCards, communityCards); err != nil {
+		return EvaluatedHand{}, nil, err
+	}
+
+	// Evaluate the best hand, or what a partial hand makes so far
+	var bestHand EvaluatedHand
+	var substitutions []*pbv1.Substitution
+	if wild, ok := variant.(wildVariant); ok {
+		wildHand := wild.EvaluateWild(holeCards, communityCards)
+		bestHand = wildHand.Hand
+		for _, sub := range wildHand.Substitutions {
+			substitutions = append(substitutions, &pbv1.Substitution{
+				WildCard: cardToProto(sub.Wild),
+				PlaysAs:  cardToProto(sub.As),
+			})
+		}
+	} else {
+		bestHand = variant.Evaluate(holeCards, communityCards)
+	}
+	resp := &pbv1.HandResponse{
+		Category:      pbv1.HandCategory(bestHand.Rank + 1),
+		CategoryName:  variant.HandName(bestHand),
+		RankValue:     bestHand.RankValue,
+		BestCards:     cardsToProto(bestHand.Cards),
+		Substitutions: substitutions,
+	}
+
+	// Draws, odds and relative strength are worked out for Hold'em only
+	if variant.Name() != VariantHoldem {
+		return bestHand, resp, nil
+	}
+
+	// Report draws while the board is incomplete
+	draws := []*pbv1.Draw{}
+	for _, draw := range FindDraws(holeCards, communityCards, bestHand) {
+		draws = append(draws, &pbv1.Draw{Name: draw.Name, Outs: cardsToProto(draw.Outs)})
+	}
+
+	// Relative strength: against every holding on a board, among starting
+	// hands preflop, otherwise against all 5-card hands
+	percentile := FiveCardPercentile(bestHand.RankValue)
+	if len(holeCards) == 2 && len(communityCards) >= 3 && len(communityCards) <= 5 {
+		percentile = BoardPercentile(holeCards, communityCards)
+	} else if len(holeCards) == 2 && len(communityCards) == 0 {
+		percentile = PreflopPercentile(holeCards)
+	}
+	fiveCard, sevenCard := CategoryProbability(bestHand.Rank)
+	relative := ClassifyHand(holeCards, communityCards, bestHand)
+
+	resp.Draws = draws
+	resp.EquivalenceClass = int32(HandClass(bestHand.RankValue))
+	resp.FiveCardProbability = fiveCard
+	resp.SevenCardProbability = sevenCard
+	resp.Percentile = percentile
+	resp.HandLabel = relative.Label
+	resp.HoleCardsUsed = int32(relative.HoleCardsUsed)
+	return bestHand, resp, nil
+}
+
+// defaultVariant resolves an unset variant to Texas Hold'em
+func defaultVariant(variant pbv1.Variant) pbv1.Variant {
+	if variant == pbv1.Variant_VARIANT_UNSPECIFIED {
+		return pbv1.Variant_VARIANT_TEXAS_HOLDEM
+	}
+	return variant
+}
+
+// variantFromProto looks up the registered variant for a proto enum, Texas
+// Hold'em when it is unset
+func variantFromProto(variant pbv1.Variant) (Variant, error) {
+	name, ok := protoVariantNames[defaultVariant(variant)]
+	if !ok {
+		return nil, fmt.Errorf("unknown variant: %s", variant)
+	}
+	return LookupVariant(name)
+}
+
+// requestVariant looks up the variant of a request, played with its wild cards
+func requestVariant(variant pbv1.Variant, wild *pbv1.WildCards) (Variant, error) {
+	v, err := variantFromProto(variant)
+	if err != nil {
+		return nil, err
+	}
+	return WithWildCards(v, wildFromProto(wild))
+}
+
+// wildFromProto converts proto wild cards, which may be unset, to rules
+func wildFromProto(wild *pbv1.WildCards) WildRules {
+	return WildRules{Jokers: int(wild.GetJokers()), Bug: wild.GetBug(), WildRank: int(wild.GetWildRank())}
+}
+
+// variantToProto returns the proto enum of a registered variant name
+func variantToProto(name string) (pbv1.Variant, error) {
+	v, err := LookupVariant(name)
+	if err != nil {
+		return 0, err
+	}
+	for variant, variantName := range protoVariantNames {
+		if variantName == v.Name() {
+			return variant, nil
+		}
+	}
+	return 0, fmt.Errorf("variant %s has no poker.v1 identifier", v.Name())
+}
+
+// cardFromProto converts a proto card, checking its rank and suit
+func cardFromProto(card *pbv1.Card) (Card, error) {
+	if joker := card.GetJoker(); joker != 0 {
+		if joker < 1 || int(joker) > len(jokers) {
+			return Card{}, fmt.Errorf("invalid joker: %d", joker)
+		}
+		return jokers[joker-1], nil
+	}
+	suit, ok := suitLetters[card.GetSuit()]
+	if !ok {
+		return Card{}, fmt.Errorf("invalid suit: %s", card.GetSuit())
+	}
+	if card.GetRank() < pbv1.Rank_RANK_TWO || card.GetRank() > pbv1.Rank_RANK_ACE {
+		return Card{}, fmt.Errorf("invalid rank: %s", card.GetRank())
+	}
+	return Card{Rank: int(card.GetRank()), Suit: suit}, nil
+}
+
+// cardsFromProto converts proto cards of the given kind
+func cardsFromProto(cards []*pbv1.Card, kind string) ([]Card, error) {
+	result := make([]Card, 0, len(cards))
+	for i, card := range cards {
+		parsed, err := cardFromProto(card)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s card %d: %v", kind, i+1, err)
+		}
+		result = append(result, parsed)
+	}
+	return result, nil
+}
+
+// cardToProto converts a card to its proto form
+func cardToProto(card Card) *pbv1.Card {
+	for i, joker := range jokers {
+		if card == joker {
+			return &pbv1.Card{Joker: int32(i + 1)}
+		}
+	}
+	return &pbv1.Card{Rank: pbv1.Rank(card.Rank), Suit: protoSuits[card.Suit]}
+}
+
+// cardsToProto converts cards to their proto form
+func cardsToProto(cards []Card) []*pbv1.Card {
+	result := make([]*pbv1.Card, len(cards))
+	for i, card := range cards {
+		result[i] = cardToProto(card)
+	}
+	return result
+}
+
+// cardStringsToProto parses card strings into proto cards
+func cardStringsToProto(cardStrs []string, kind string) ([]*pbv1.Card, error) {
+	cards, err := parseWildCards(cardStrs, kind)
+	if err != nil {
+		return nil, err
+	}
+	return cardsToProto(cards), nil
+}
+
+// protoToCardStrings formats proto cards as strings, which need no checking
+// since the server produced them
+func protoToCardStrings(cards []*pbv1.Card) []string {
+	result := make([]string, len(cards))
+	for i, card := range cards {
+		if joker := card.GetJoker(); joker != 0 {
+			result[i] = CardToString(jokers[joker-1])
+			continue
+		}
+		result[i] = CardToString(Card{Rank: int(card.GetRank()), Suit: suitLetters[card.GetSuit()]})
+	}
+	return result
+}
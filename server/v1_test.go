@@ -0,0 +1,37 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	pbv1 "github.com/mispice/Poker-dist-assignment/proto/v1"
+)
+
+func TestUnspecifiedVariantIsHoldem(t *testing.T) {
+	hand := func(variant pbv1.Variant, hole string) *pbv1.HandRequest {
+		return &pbv1.HandRequest{
+			Variant:        variant,
+			HoleCards:      cardsToProto(mustParseHand(t, hole)),
+			CommunityCards: cardsToProto(mustParseHand(t, "Qh Jd 7c 4s 2h")),
+		}
+	}
+
+	resp, err := NewPokerServerV1().CompareHands(context.Background(), &pbv1.CompareRequest{
+		Hand1: hand(pbv1.Variant_VARIANT_UNSPECIFIED, "Ah Kd"),
+		Hand2: hand(pbv1.Variant_VARIANT_TEXAS_HOLDEM, "As Qc"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Winner != 2 {
+		t.Errorf("got winner %d, want 2", resp.Winner)
+	}
+
+	_, err = NewPokerServerV1().CompareHands(context.Background(), &pbv1.CompareRequest{
+		Hand1: hand(pbv1.Variant_VARIANT_UNSPECIFIED, "Ah Kd"),
+		Hand2: hand(pbv1.Variant_VARIANT_OMAHA, "As Qc"),
+	})
+	if err == nil {
+		t.Error("an unset variant compared with an Omaha hand")
+	}
+}
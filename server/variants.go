@@ -0,0 +1,414 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Variant is a poker game's rules: the deck, how many cards are dealt, which
+// of them make a hand and how hands are ordered
+type Variant interface {
+	Name() string
+	Deck() []Card
+	HoleCards() int  // Cards dealt to each player
+	BoardCards() int // Community cards on a full board, 0 for games without one
+	Validate(holeCards []Card, communityCards []Card) error
+	Evaluate(holeCards []Card, communityCards []Card) EvaluatedHand // Higher RankValue wins
+	HandName(hand EvaluatedHand) string
+	StandardRanking() bool // Hands rank as in Hold'em, so tie-breaks can be explained
+}
+
+// Names of the registered variants
+const (
+	VariantHoldem       = "holdem"
+	VariantOmaha        = "omaha"
+	VariantShortDeck    = "short-deck"
+	VariantRazz         = "razz"
+	VariantDeuceToSeven = "deuce-to-seven"
+)
+
+// lowballCeiling turns lowball scores, where lower is better, into rank
+// values where higher wins
+const lowballCeiling = 100000000
+
+var variants = make(map[string]Variant)
+
+func init() {
+	RegisterVariant(holdem{})
+	RegisterVariant(omaha{})
+	RegisterVariant(shortDeck{})
+	RegisterVariant(razz{})
+	RegisterVariant(deuceToSeven{})
+}
+
+// RegisterVariant makes a variant available by its name
+func RegisterVariant(v Variant) {
+	variants[v.Name()] = v
+}
+
+// LookupVariant returns a registered variant; an empty name means Hold'em
+func LookupVariant(name string) (Variant, error) {
+	if name == "" {
+		name = VariantHoldem
+	}
+	v, ok := variants[strings.ToLower(name)]
+	if !ok {
+		return nil, fmt.Errorf("unknown variant %q, expected one of %s", name, strings.Join(VariantNames(), ", "))
+	}
+	return v, nil
+}
+
+// VariantNames lists the registered variants in alphabetical order
+func VariantNames() []string {
+	names := make([]string, 0, len(variants))
+	for name := range variants {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// holdem is Texas Hold'em: the best five of two hole cards and five on board
+type holdem struct{}
+
+func (holdem) Name() string          { return VariantHoldem }
+func (holdem) Deck() []Card          { return newDeck() }
+func (holdem) HoleCards() int        { return 2 }
+func (holdem) BoardCards() int       { return 5 }
+func (holdem) StandardRanking() bool { return true }
+
+func (v holdem) Validate(holeCards []Card, communityCards []Card) error {
+	return validateDeal(v, holeCards, communityCards)
+}
+
+func (holdem) Evaluate(holeCards []Card, communityCards []Card) EvaluatedHand {
+	return EvaluateBestHand(append(append([]Card{}, holeCards...), communityCards...))
+}
+
+func (holdem) HandName(hand EvaluatedHand) string {
+	return GetHandName(hand.Rank)
+}
+
+// omaha deals four hole cards, of which exactly two play with three from the board
+type omaha struct{}
+
+func (omaha) Name() string          { return VariantOmaha }
+func (omaha) Deck() []Card          { return newDeck() }
+func (omaha) HoleCards() int        { return 4 }
+func (omaha) BoardCards() int       { return 5 }
+func (omaha) StandardRanking() bool { return true }
+
+func (v omaha) Validate(holeCards []Card, communityCards []Card) error {
+	return validateDeal(v, holeCards, communityCards)
+}
+
+// Evaluate plays two hole cards (fewer if fewer are known) with up to three
+// board cards
+func (omaha) Evaluate(holeCards []Card, communityCards []Card) EvaluatedHand {
+	holeCombos := generateCombinations(holeCards, minInt(2, len(holeCards)))
+	boardCombos := generateCombinations(communityCards, minInt(3, len(communityCards)))
+
+	var best EvaluatedHand
+	for i, hole := range holeCombos {
+		for j, board := range boardCombos {
+			hand := EvaluateBestHand(append(append([]Card{}, hole...), board...))
+			if (i == 0 && j == 0) || hand.RankValue > best.RankValue {
+				best = hand
+			}
+		}
+	}
+	return best
+}
+
+func (omaha) HandName(hand EvaluatedHand) string {
+	return GetHandName(hand.Rank)
+}
+
+// shortDeck is Hold'em with the 2s to 5s removed. A-6-7-8-9 is the lowest
+// straight, and a flush beats a full house because it is rarer.
+type shortDeck struct{}
+
+func (shortDeck) Name() string          { return VariantShortDeck }
+func (shortDeck) HoleCards() int        { return 2 }
+func (shortDeck) BoardCards() int       { return 5 }
+func (shortDeck) StandardRanking() bool { return false }
+
+func (shortDeck) Deck() []Card {
+	deck := []Card{}
+	for _, card := range newDeck() {
+		if card.Rank >= 6 {
+			deck = append(deck, card)
+		}
+	}
+	return deck
+}
+
+func (v shortDeck) Validate(holeCards []Card, communityCards []Card) error {
+	return validateDeal(v, holeCards, communityCards)
+}
+
+func (shortDeck) Evaluate(holeCards []Card, communityCards []Card) EvaluatedHand {
+	cards := append(append([]Card{}, holeCards...), communityCards...)
+	if len(cards) < 5 {
+		return EvaluatePartialHand(cards)
+	}
+
+	var best EvaluatedHand
+	for i, combo := range generateCombinations(cards, 5) {
+		hand := evaluateFiveCards(combo)
+		if isShortDeckWheel(hand.Cards) {
+			hand.Rank = Straight
+			if checkFlush(hand.Cards) {
+				hand.Rank = StraightFlush
+			}
+			hand.RankValue = int32(hand.Rank)*10000000 + 9*100000
+		}
+		switch hand.Rank {
+		case Flush:
+			hand.RankValue += 10000000
+		case FullHouse:
+			hand.RankValue -= 10000000
+		}
+		if i == 0 || hand.RankValue > best.RankValue {
+			best = hand
+		}
+	}
+	return best
+}
+
+func (shortDeck) HandName(hand EvaluatedHand) string {
+	return GetHandName(hand.Rank)
+}
+
+// isShortDeckWheel reports whether cards sorted high to low are A-9-8-7-6
+func isShortDeckWheel(sorted []Card) bool {
+	wheel := []int{14, 9, 8, 7, 6}
+	for i, card := range sorted {
+		if card.Rank != wheel[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// razz is seven-card stud for the lowest hand: aces are low and straights
+// and flushes do not count, so 5-4-3-2-A is the best hand
+type razz struct{}
+
+func (razz) Name() string          { return VariantRazz }
+func (razz) Deck() []Card          { return newDeck() }
+func (razz) HoleCards() int        { return 7 }
+func (razz) BoardCards() int       { return 0 }
+func (razz) StandardRanking() bool { return false }
+
+func (v razz) Validate(holeCards []Card, communityCards []Card) error {
+	return validateDeal(v, holeCards, communityCards)
+}
+
+func (razz) Evaluate(holeCards []Card, communityCards []Card) EvaluatedHand {
+	combos := [][]Card{holeCards}
+	if len(holeCards) > 5 {
+		combos = generateCombinations(holeCards, 5)
+	}
+
+	var best EvaluatedHand
+	for i, combo := range combos {
+		rank, score := aceToFiveScore(combo)
+		if i == 0 || lowballCeiling-score > best.RankValue {
+			best = EvaluatedHand{Rank: rank, Cards: sortAceLow(combo), RankValue: lowballCeiling - score}
+		}
+	}
+	return best
+}
+
+func (razz) HandName(hand EvaluatedHand) string {
+	return lowballName(hand)
+}
+
+// deuceToSeven is five-card draw for the lowest hand: aces are high and
+// straights and flushes count against, so 7-5-4-3-2 is the best hand
+type deuceToSeven struct{}
+
+func (deuceToSeven) Name() string          { return VariantDeuceToSeven }
+func (deuceToSeven) Deck() []Card          { return newDeck() }
+func (deuceToSeven) HoleCards() int        { return 5 }
+func (deuceToSeven) BoardCards() int       { return 0 }
+func (deuceToSeven) StandardRanking() bool { return false }
+
+func (v deuceToSeven) Validate(holeCards []Card, communityCards []Card) error {
+	return validateDeal(v, holeCards, communityCards)
+}
+
+func (deuceToSeven) Evaluate(holeCards []Card, communityCards []Card) EvaluatedHand {
+	if len(holeCards) < 5 {
+		hand := EvaluatePartialHand(holeCards)
+		hand.RankValue = lowballCeiling - hand.RankValue
+		return hand
+	}
+
+	hand := evaluateFiveCards(holeCards)
+	// The ace only plays high, so A-5-4-3-2 is not a straight
+	if hand.Rank == Straight || hand.Rank == StraightFlush {
+		if _, high := checkStraight(hand.Cards); high == 5 {
+			hand.Rank = HighCard
+			if checkFlush(hand.Cards) {
+				hand.Rank = Flush
+			}
+			hand.RankValue = int32(hand.Rank)*10000000 + highCardValue(hand.Cards)
+		}
+	}
+	hand.RankValue = lowballCeiling - hand.RankValue
+	return hand
+}
+
+func (deuceToSeven) HandName(hand EvaluatedHand) string {
+	return lowballName(hand)
+}
+
+// aceToFiveScore scores up to five cards with aces low, ignoring straights
+// and flushes. Lower scores are better.
+func aceToFiveScore(cards []Card) (HandRank, int32) {
+	low := make([]Card, len(cards))
+	for i, card := range cards {
+		low[i] = card
+		if card.Rank == 14 {
+			low[i].Rank = 1
+		}
+	}
+	ranks := groupedRanks(low)
+	counts := make(map[int]int)
+	for _, card := range low {
+		counts[card.Rank]++
+	}
+
+	rank := HighCard
+	switch {
+	case counts[ranks[0]] == 4:
+		rank = FourOfAKind
+	case counts[ranks[0]] == 3 && len(ranks) > 1 && counts[ranks[1]] == 2:
+		rank = FullHouse
+	case counts[ranks[0]] == 3:
+		rank = ThreeOfAKind
+	case counts[ranks[0]] == 2 && len(ranks) > 1 && counts[ranks[1]] == 2:
+		rank = TwoPair
+	case counts[ranks[0]] == 2:
+		rank = OnePair
+	}
+
+	// The largest group first, then the highest card; each counts for more
+	// than all that follow
+	score := int32(rank) * 10000000
+	weight := int32(15 * 15 * 15 * 15)
+	for _, r := range ranks {
+		score += int32(r) * weight
+		weight /= 15
+	}
+	return rank, score
+}
+
+// sortAceLow orders cards from high to low with aces at the bottom
+func sortAceLow(cards []Card) []Card {
+	sorted := append([]Card{}, cards...)
+	low := func(c Card) int {
+		if c.Rank == 14 {
+			return 1
+		}
+		return c.Rank
+	}
+	sort.Slice(sorted, func(i, j int) bool { return low(sorted[i]) > low(sorted[j]) })
+	return sorted
+}
+
+// lowballName names a lowball hand by its cards when unpaired, e.g. "7-5-4-3-2 Low"
+func lowballName(hand EvaluatedHand) string {
+	if hand.Rank != HighCard {
+		return GetHandName(hand.Rank)
+	}
+	ranks := make([]string, len(hand.Cards))
+	for i, card := range hand.Cards {
+		ranks[i] = RankToString(card.Rank)
+	}
+	return strings.Join(ranks, "-") + " Low"
+}
+
+// validateDeal checks that cards come from the variant's deck, are not
+// repeated and do not exceed what the variant deals
+func validateDeal(v Variant, holeCards []Card, communityCards []Card) error {
+	if len(holeCards) > v.HoleCards() {
+		return fmt.Errorf("%s deals %d hole cards, got %d", v.Name(), v.HoleCards(), len(holeCards))
+	}
+	if len(communityCards) > v.BoardCards() {
+		if v.BoardCards() == 0 {
+			return fmt.Errorf("%s has no community cards, got %d", v.Name(), len(communityCards))
+		}
+		return fmt.Errorf("%s has at most %d community cards, got %d", v.Name(), v.BoardCards(), len(communityCards))
+	}
+
+	inDeck := make(map[Card]bool)
+	for _, card := range v.Deck() {
+		inDeck[card] = true
+	}
+	for _, card := range append(append([]Card{}, holeCards...), communityCards...) {
+		if !inDeck[card] {
+			return fmt.Errorf("%s is not in the %s deck", CardToString(card), v.Name())
+		}
+	}
+	return checkDistinct(holeCards, communityCards)
+}
+
+// SimulateVariant estimates hero's win/tie/lose probabilities against one
+// random opponent in any variant, dealing the opponent's cards, hero's
+// missing cards and the rest of the board from the variant's deck
+func SimulateVariant(v Variant, holeCards []Card, communityCards []Card, numSimulations int) SimulationResult {
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	dead := make(map[Card]bool)
+	for _, card := range append(append([]Card{}, holeCards...), communityCards...) {
+		dead[card] = true
+	}
+	deck := []Card{}
+	for _, card := range v.Deck() {
+		if !dead[card] {
+			deck = append(deck, card)
+		}
+	}
+
+	heroMissing := v.HoleCards() - len(holeCards)
+	boardMissing := v.BoardCards() - len(communityCards)
+	dealSize := heroMissing + boardMissing + v.HoleCards()
+
+	var tally outcomeTally
+	for i := 0; i < numSimulations; i++ {
+		dealPrefix(rng, deck, 0, dealSize)
+		hero := append(append([]Card{}, holeCards...), deck[:heroMissing]...)
+		board := append(append([]Card{}, communityCards...), deck[heroMissing:heroMissing+boardMissing]...)
+		opponent := deck[heroMissing+boardMissing : dealSize]
+
+		switch compareValues(v.Evaluate(hero, board).RankValue, v.Evaluate(opponent, board).RankValue) {
+		case handAhead:
+			tally.add(1)
+		case handTied:
+			tally.add(0.5)
+		default:
+			tally.add(0)
+		}
+	}
+
+	// Each trial scores 1, 0.5 or 0
+	n := float64(numSimulations)
+	mean := (float64(tally.wins) + float64(tally.ties)/2) / n
+	variance := (float64(tally.wins)+float64(tally.ties)/4)/n - mean*mean
+	return tally.result(math.Sqrt(math.Max(variance, 0)/n), EstimatorNaive)
+}
+
+// minInt returns the smaller of two ints
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
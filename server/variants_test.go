@@ -0,0 +1,98 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+// evaluateVariant scores a hand written "hole | board", or just the hole
+// cards for games without a board
+func evaluateVariant(t *testing.T, v Variant, hand string) EvaluatedHand {
+	t.Helper()
+	holeText, boardText, _ := strings.Cut(hand, "|")
+	hole, board := mustParseHand(t, holeText), mustParseHand(t, boardText)
+	if err := v.Validate(hole, board); err != nil {
+		t.Fatalf("%s %q: %v", v.Name(), hand, err)
+	}
+	return v.Evaluate(hole, board)
+}
+
+func TestVariantOrdering(t *testing.T) {
+	tests := []struct {
+		variant       string
+		better, worse string
+	}{
+		// Short deck: a flush beats a full house and A-9-8-7-6 is the lowest straight
+		{VariantShortDeck, "Jh 9h | 8h 7h 6h", "Ac Ad | As Kc Kd"},
+		{VariantShortDeck, "Qc Qd | Qs Qh 6c", "Jh 9h | 8h 7h 6h"},
+		{VariantShortDeck, "Ac Ad | As Kc Kd", "Tc 9c | 8d 7s 6h"},
+		{VariantShortDeck, "Ah 9c | 8d 7s 6h", "Kc Kd | Ks Qc 9d"},
+		{VariantShortDeck, "Tc 9c | 8d 7s 6h", "Ah 9c | 8d 7s 6h"},
+		{VariantShortDeck, "Ah 9h | 8h 7h 6h", "Qc Qd | Qs Qh 6c"},
+
+		// Razz: aces are low and straights and flushes do not count
+		{VariantRazz, "5c 4d 3h 2s Ac", "6c 4d 3h 2s Ac"},
+		{VariantRazz, "5h 4h 3h 2h Ah", "6c 4d 3h 2s Ac"},
+		{VariantRazz, "6c 4d 3h 2s Ac", "7c 5d 4h 3s 2c"},
+		{VariantRazz, "Kd Qc Jh 9s 8d", "2c 2d 3h 4s 5c"},
+		{VariantRazz, "Kd Kc 5c 4d 3h 2s Ac", "8c 7d 6h 4s 3c 2d Kh"},
+
+		// Deuce-to-seven: aces are high and straights and flushes count against
+		{VariantDeuceToSeven, "7c 5d 4h 3s 2c", "8c 5d 4h 3s 2c"},
+		{VariantDeuceToSeven, "7c 5d 4h 3s 2c", "Ac 5d 4h 3s 2c"},
+		{VariantDeuceToSeven, "Ac 5d 4h 3s 2c", "Ac Ad 4h 3s 2c"},
+		{VariantDeuceToSeven, "Kc Qd Jh 9s 8c", "6c 5d 4h 3s 2c"},
+		{VariantDeuceToSeven, "8c 6d 5h 4s 3c", "7h 5h 4h 3h 2h"},
+	}
+
+	for _, tt := range tests {
+		v, err := LookupVariant(tt.variant)
+		if err != nil {
+			t.Fatalf("LookupVariant(%q): %v", tt.variant, err)
+		}
+		better, worse := evaluateVariant(t, v, tt.better), evaluateVariant(t, v, tt.worse)
+		if better.RankValue <= worse.RankValue {
+			t.Errorf("%s: %q (%s, %d) should beat %q (%s, %d)", tt.variant,
+				tt.better, v.HandName(better), better.RankValue,
+				tt.worse, v.HandName(worse), worse.RankValue)
+		}
+	}
+}
+
+func TestVariantHandRanks(t *testing.T) {
+	tests := []struct {
+		variant string
+		hand    string
+		want    HandRank
+	}{
+		{VariantShortDeck, "Ah 9c | 8d 7s 6h", Straight},
+		{VariantShortDeck, "Ah 9h | 8h 7h 6h", StraightFlush},
+		{VariantHoldem, "Ah 9c | 8d 7s 6h", HighCard},
+		{VariantRazz, "5c 4d 3h 2s Ac", HighCard},
+		{VariantRazz, "5h 4h 3h 2h Ah", HighCard},
+		{VariantDeuceToSeven, "Ac 5d 4h 3s 2c", HighCard},
+		{VariantDeuceToSeven, "Ah 5h 4h 3h 2h", Flush},
+		{VariantDeuceToSeven, "6c 5d 4h 3s 2c", Straight},
+	}
+
+	for _, tt := range tests {
+		v, err := LookupVariant(tt.variant)
+		if err != nil {
+			t.Fatalf("LookupVariant(%q): %v", tt.variant, err)
+		}
+		if got := evaluateVariant(t, v, tt.hand); got.Rank != tt.want {
+			t.Errorf("%s %q = %s, want %s", tt.variant, tt.hand, handNames[got.Rank], handNames[tt.want])
+		}
+	}
+}
+
+func TestRazzHandName(t *testing.T) {
+	razz, err := LookupVariant(VariantRazz)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hand := evaluateVariant(t, razz, "Kd 7c 5d 4h 3s 2c Ac")
+	if got := razz.HandName(hand); got != "5-4-3-2-A Low" {
+		t.Errorf("HandName = %q, want %q", got, "5-4-3-2-A Low")
+	}
+}
@@ -0,0 +1,341 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// The two jokers a wild deck can add, written "JK1" and "JK2". A joker has no
+// rank or suit until it is substituted.
+var jokers = []Card{{Rank: 0, Suit: "JK1"}, {Rank: 0, Suit: "JK2"}}
+
+// IsJoker reports whether a card is one of the jokers
+func IsJoker(c Card) bool {
+	return c.Rank == 0 && strings.HasPrefix(c.Suit, "JK")
+}
+
+// WildRules describe which cards are wild
+type WildRules struct {
+	Jokers   int  // Jokers added to the deck, 0 to 2
+	Bug      bool // Jokers are bugs: they only play as aces or to complete a straight or flush
+	WildRank int  // Every card of this rank is wild, e.g. 2 for deuces wild; 0 for none
+}
+
+// Active reports whether any card is wild under the rules
+func (r WildRules) Active() bool {
+	return r.Jokers > 0 || r.WildRank != 0
+}
+
+// Validate checks the rules describe a playable deck
+func (r WildRules) Validate() error {
+	if r.Jokers < 0 || r.Jokers > len(jokers) {
+		return fmt.Errorf("a deck holds 0 to %d jokers, got %d", len(jokers), r.Jokers)
+	}
+	if r.WildRank != 0 && (r.WildRank < 2 || r.WildRank > 14) {
+		return fmt.Errorf("invalid wild rank: %d", r.WildRank)
+	}
+	if r.Bug && r.Jokers == 0 {
+		return fmt.Errorf("bug rules need at least one joker")
+	}
+	return nil
+}
+
+// Substitution records the card a wild card plays as
+type Substitution struct {
+	Wild Card
+	As   Card
+}
+
+// WildHand is the best hand made with wild cards
+type WildHand struct {
+	Hand          EvaluatedHand // Best five cards with the wild cards replaced
+	Substitutions []Substitution
+}
+
+// EvaluateWild finds the best hand when wild cards may stand for any card,
+// including a fifth card of a rank for Five of a Kind. Bug jokers only play
+// as aces or to complete a straight or flush.
+func EvaluateWild(cards []Card, rules WildRules) WildHand {
+	var naturals, wilds, bugs []Card
+	for _, card := range cards {
+		switch {
+		case IsJoker(card) && rules.Bug:
+			bugs = append(bugs, card)
+		case IsJoker(card) || (rules.WildRank != 0 && card.Rank == rules.WildRank):
+			wilds = append(wilds, card)
+		default:
+			naturals = append(naturals, card)
+		}
+	}
+	if len(wilds)+len(bugs) == 0 {
+		return WildHand{Hand: EvaluateBestHand(cards)}
+	}
+
+	// Every wild card may complete a straight or flush; otherwise bugs are aces
+	candidates := []WildHand{groupedWildHand(naturals, wilds, bugs, len(cards))}
+	if len(cards) >= 5 {
+		anyWild := append(append([]Card{}, wilds...), bugs...)
+		candidates = append(candidates, straightWildHand(naturals, anyWild, ""))
+		for _, suit := range []string{"H", "D", "C", "S"} {
+			candidates = append(candidates, straightWildHand(naturals, anyWild, suit), flushWildHand(naturals, anyWild, suit))
+		}
+	}
+
+	best := candidates[0]
+	for _, candidate := range candidates[1:] {
+		if candidate.Hand.RankValue > best.Hand.RankValue {
+			best = candidate
+		}
+	}
+	return best
+}
+
+// groupedWildHand makes the best hand of matched ranks: Five of a Kind,
+// quads, a full house, trips, two pair, a pair or high card. Bugs join the
+// naturals as aces and the other wild cards fill out the groups.
+func groupedWildHand(naturals, wilds, bugs []Card, total int) WildHand {
+	pool := append([]Card{}, naturals...)
+	subs := []Substitution{}
+	for _, bug := range bugs {
+		ace := Card{Rank: 14, Suit: unusedSuit(pool, 14)}
+		pool = append(pool, ace)
+		subs = append(subs, Substitution{Wild: bug, As: ace})
+	}
+	size := minInt(5, total)
+
+	// Shapes from best to worst; the first that fits, with the highest ranks,
+	// is the best grouped hand
+	shapes := [][]int{{5}, {4}, {3, 2}, {3}, {2, 2}, {2}, {}}
+	for _, shape := range shapes {
+		sum := 0
+		for _, n := range shape {
+			sum += n
+		}
+		if sum > size {
+			continue
+		}
+		ranks, ok := fitShape(pool, len(wilds), shape)
+		if !ok {
+			continue
+		}
+
+		hand := []Card{}
+		wildsLeft := wilds
+		playAs := func(card Card) {
+			subs = append(subs, Substitution{Wild: wildsLeft[0], As: card})
+			wildsLeft = wildsLeft[1:]
+			hand = append(hand, card)
+		}
+		for i, rank := range ranks {
+			naturalsOfRank := cardsOfRank(pool, rank)
+			for j := 0; j < shape[i]; j++ {
+				if j < len(naturalsOfRank) {
+					hand = append(hand, naturalsOfRank[j])
+				} else {
+					playAs(Card{Rank: rank, Suit: unusedSuit(append(append([]Card{}, pool...), hand...), rank)})
+				}
+			}
+		}
+
+		// Kickers: leftover wild cards play as the highest free ranks, then
+		// the highest naturals of other ranks
+		for rank := 14; rank >= 2 && len(hand) < size && len(wildsLeft) > 0; rank-- {
+			if !containsRank(ranks, rank) && len(cardsOfRank(pool, rank)) == 0 {
+				playAs(Card{Rank: rank, Suit: unusedSuit(pool, rank)})
+			}
+		}
+		kickers := []Card{}
+		for _, card := range pool {
+			if !containsRank(ranks, card.Rank) {
+				kickers = append(kickers, card)
+			}
+		}
+		sort.Slice(kickers, func(i, j int) bool { return kickers[i].Rank > kickers[j].Rank })
+		hand = append(hand, kickers[:minInt(len(kickers), size-len(hand))]...)
+
+		return WildHand{Hand: evaluateWildCards(hand), Substitutions: playedSubstitutions(subs, hand)}
+	}
+	return WildHand{Hand: evaluateWildCards(pool), Substitutions: subs}
+}
+
+// fitShape picks the highest ranks for groups of the given sizes, using at
+// most the given number of wild cards to fill them
+func fitShape(pool []Card, wilds int, shape []int) ([]int, bool) {
+	counts := make(map[int]int)
+	for _, card := range pool {
+		counts[card.Rank]++
+	}
+
+	var pick func(i, wildsLeft int, ranks []int) ([]int, bool)
+	pick = func(i, wildsLeft int, ranks []int) ([]int, bool) {
+		if i == len(shape) {
+			return ranks, true
+		}
+		for rank := 14; rank >= 2; rank-- {
+			if containsRank(ranks, rank) {
+				continue
+			}
+			need := shape[i] - minInt(counts[rank], shape[i])
+			if need > wildsLeft {
+				continue
+			}
+			if found, ok := pick(i+1, wildsLeft-need, append(append([]int{}, ranks...), rank)); ok {
+				return found, true
+			}
+		}
+		return nil, false
+	}
+	return pick(0, wilds, []int{})
+}
+
+// straightWildHand makes the highest straight, or the highest straight flush
+// in a suit when one is given, filling the gaps with wild cards
+func straightWildHand(naturals, wilds []Card, suit string) WildHand {
+	for high := 14; high >= 5; high-- {
+		hand, subs := []Card{}, []Substitution{}
+		for i := 0; i < 5 && len(subs) <= len(wilds); i++ {
+			rank := high - i
+			if rank == 1 {
+				rank = 14 // The wheel plays the ace low
+			}
+			if card, ok := naturalOf(naturals, rank, suit); ok {
+				hand = append(hand, card)
+				continue
+			}
+			as := Card{Rank: rank, Suit: suit}
+			if suit == "" {
+				as.Suit = unusedSuit(naturals, rank)
+			}
+			if len(subs) < len(wilds) {
+				subs = append(subs, Substitution{Wild: wilds[len(subs)], As: as})
+			} else {
+				subs = append(subs, Substitution{}) // Too many gaps
+			}
+			hand = append(hand, as)
+		}
+		if len(subs) <= len(wilds) {
+			return WildHand{Hand: evaluateWildCards(hand), Substitutions: subs}
+		}
+	}
+	return WildHand{}
+}
+
+// flushWildHand makes the highest flush in a suit, with wild cards playing
+// as the highest ranks of the suit the naturals lack
+func flushWildHand(naturals, wilds []Card, suit string) WildHand {
+	hand, subs := []Card{}, []Substitution{}
+	for rank := 14; rank >= 2 && len(hand) < 5; rank-- {
+		if card, ok := naturalOf(naturals, rank, suit); ok {
+			hand = append(hand, card)
+		} else if len(subs) < len(wilds) {
+			as := Card{Rank: rank, Suit: suit}
+			subs = append(subs, Substitution{Wild: wilds[len(subs)], As: as})
+			hand = append(hand, as)
+		}
+	}
+	if len(hand) < 5 {
+		return WildHand{}
+	}
+	return WildHand{Hand: evaluateWildCards(hand), Substitutions: subs}
+}
+
+// evaluateWildCards scores a hand whose wild cards have been substituted,
+// which can be Five of a Kind
+func evaluateWildCards(cards []Card) EvaluatedHand {
+	if len(cards) == 5 && len(cardsOfRank(cards, cards[0].Rank)) == 5 {
+		return EvaluatedHand{
+			Rank:      FiveOfAKind,
+			Cards:     cards,
+			RankValue: int32(FiveOfAKind)*10000000 + int32(cards[0].Rank)*100000,
+		}
+	}
+	if len(cards) < 5 {
+		return EvaluatePartialHand(cards)
+	}
+	return evaluateFiveCards(cards)
+}
+
+// naturalOf finds a card of the rank, and of the suit unless it is empty
+func naturalOf(cards []Card, rank int, suit string) (Card, bool) {
+	for _, card := range cards {
+		if card.Rank == rank && (suit == "" || card.Suit == suit) {
+			return card, true
+		}
+	}
+	return Card{}, false
+}
+
+// unusedSuit returns a suit no card of the rank has yet, or spades when all
+// four are out, as for the fifth card of Five of a Kind
+func unusedSuit(cards []Card, rank int) string {
+	for _, suit := range []string{"S", "H", "D", "C"} {
+		if _, ok := naturalOf(cards, rank, suit); !ok {
+			return suit
+		}
+	}
+	return "S"
+}
+
+// playedSubstitutions keeps the substitutions whose cards made the hand
+func playedSubstitutions(subs []Substitution, hand []Card) []Substitution {
+	played := []Substitution{}
+	for _, sub := range subs {
+		for i, card := range hand {
+			if card == sub.As {
+				played = append(played, sub)
+				hand = append(append([]Card{}, hand[:i]...), hand[i+1:]...)
+				break
+			}
+		}
+	}
+	return played
+}
+
+// WildDeck returns a deck with the rules' jokers added
+func WildDeck(deck []Card, rules WildRules) []Card {
+	return append(append([]Card{}, deck...), jokers[:rules.Jokers]...)
+}
+
+// wildVariant plays a high-hand variant with wild cards, dealing from the
+// deck with its jokers
+type wildVariant struct {
+	Variant
+	rules WildRules
+}
+
+// WithWildCards applies wild card rules to a variant. Only Hold'em, where
+// any five cards play, is supported.
+func WithWildCards(v Variant, rules WildRules) (Variant, error) {
+	if err := rules.Validate(); err != nil {
+		return nil, err
+	}
+	if !rules.Active() {
+		return v, nil
+	}
+	if v.Name() != VariantHoldem {
+		return nil, fmt.Errorf("wild cards are not supported in %s", v.Name())
+	}
+	return wildVariant{Variant: v, rules: rules}, nil
+}
+
+func (v wildVariant) Name() string { return v.Variant.Name() + " with wild cards" }
+
+func (v wildVariant) Deck() []Card { return WildDeck(v.Variant.Deck(), v.rules) }
+
+func (v wildVariant) Validate(holeCards []Card, communityCards []Card) error {
+	return validateDeal(v, holeCards, communityCards)
+}
+
+func (v wildVariant) Evaluate(holeCards []Card, communityCards []Card) EvaluatedHand {
+	return v.EvaluateWild(holeCards, communityCards).Hand
+}
+
+// EvaluateWild evaluates a deal, reporting what each wild card plays as
+func (v wildVariant) EvaluateWild(holeCards []Card, communityCards []Card) WildHand {
+	return EvaluateWild(append(append([]Card{}, holeCards...), communityCards...), v.rules)
+}
+
+func (v wildVariant) HandName(hand EvaluatedHand) string { return GetHandName(hand.Rank) }
+
+func (v wildVariant) StandardRanking() bool { return false }
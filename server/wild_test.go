@@ -0,0 +1,61 @@
+package main
+
+import "testing"
+
+func TestEvaluateWild(t *testing.T) {
+	jokers1 := WildRules{Jokers: 1}
+	jokers2 := WildRules{Jokers: 2}
+	bug := WildRules{Jokers: 1, Bug: true}
+	deuces := WildRules{WildRank: 2}
+
+	tests := []struct {
+		name  string
+		hand  string
+		rules WildRules
+		want  HandRank
+		subs  int
+	}{
+		{"four aces and a joker", "Ah Ad Ac As JK1", jokers1, FiveOfAKind, 1},
+		{"joker pairs the top card", "Kh 9d 7c 4s JK1", jokers1, OnePair, 1},
+		{"bug plays as an ace", "Kh Kd 7c 4s JK1", bug, OnePair, 1},
+		{"bug makes trip aces", "Ah Ad 7c 4s JK1", bug, ThreeOfAKind, 1},
+		{"bug cannot make trip kings", "Kh Kd 7c 4s JK1 Ac", bug, TwoPair, 1},
+		{"bug completes a straight", "9h 8d 7c 6s JK1", bug, Straight, 1},
+		{"bug completes a flush", "2h 7h 9h Kh JK1", bug, Flush, 1},
+		{"bug completes a straight flush", "9h 8h 7h 6h JK1", bug, StraightFlush, 1},
+		{"deuces make quads", "2h 2d Kc Ks 7h", deuces, FourOfAKind, 2},
+		{"deuce completes a straight flush", "2c 5h 6h 7h 8h", deuces, StraightFlush, 1},
+		{"four deuces and a king", "2h 2d 2c 2s Kh", deuces, FiveOfAKind, 4},
+		{"two jokers and trip aces", "Ah Ad Ac JK1 JK2", jokers2, FiveOfAKind, 2},
+		{"two jokers complete a royal flush", "Th Jh Qh 3c 4d JK1 JK2", jokers2, StraightFlush, 2},
+		{"no wild cards", "Ah Kd 7c 4s 2h", jokers1, HighCard, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := EvaluateWild(mustParseHand(t, tt.hand), tt.rules)
+			if got.Hand.Rank != tt.want {
+				t.Errorf("rank = %v, want %v", handNames[got.Hand.Rank], handNames[tt.want])
+			}
+			if len(got.Substitutions) != tt.subs {
+				t.Errorf("got %d substitutions, want %d", len(got.Substitutions), tt.subs)
+			}
+		})
+	}
+}
+
+func TestEvaluateWildBugPrefersAce(t *testing.T) {
+	// A bug joker with a pair of kings is an ace kicker, not a third king
+	kings := EvaluateWild(mustParseHand(t, "Kh Kd 7c 4s JK1"), WildRules{Jokers: 1, Bug: true})
+	for _, sub := range kings.Substitutions {
+		if sub.As.Rank != 14 {
+			t.Errorf("bug played as rank %d, want an ace", sub.As.Rank)
+		}
+	}
+
+	// A full joker does make the third king, so it beats the bug
+	wild := EvaluateWild(mustParseHand(t, "Kh Kd 7c 4s JK1"), WildRules{Jokers: 1})
+	if wild.Hand.RankValue <= kings.Hand.RankValue {
+		t.Errorf("wild joker %s should beat bug %s", handNames[wild.Hand.Rank], handNames[kings.Hand.Rank])
+	}
+}